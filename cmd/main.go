@@ -1,7 +1,21 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
 	_ "github.com/AhmadKusumahDEV/Warehouse-Management-System/docs"
+	database "github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/config"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/logging"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/middleware"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/wms"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -20,21 +34,148 @@ import (
 
 // @host      localhost:8080
 // @BasePath  /api/v1
+
+// @securityDefinitions.apikey  BearerAuth
+// @in                          header
+// @name                        Authorization
 func main() {
-	// 4. Buat router gin.Default()
-	r := gin.Default()
-	r.GET("/", func(ctx *gin.Context) {
-		ctx.JSON(201, "jawa jawa jawa")
+	// Konfigurasi dibaca sekali dari file dan variabel WMS_* lalu diteruskan ke semua komponen
+	config, err := database.Load(os.Getenv(database.ConfigFileEnv))
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	// `wms migrate ...` hanya membutuhkan konfigurasi log dan database
+	migrateOnly := len(os.Args) > 1 && os.Args[1] == "migrate"
+	if migrateOnly {
+		err = errors.Join(config.Log.Validate(), config.DB.Validate())
+	} else {
+		err = config.Validate()
+	}
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	// Logger disiapkan sebelum koneksi database supaya log koneksi juga terstruktur.
+	// slog.SetDefault juga mengarahkan package log standar ke logger yang sama.
+	logger, err := logging.New(os.Stdout, config.Log.Format, config.Log.Level)
+	if err != nil {
+		log.Fatalf("Failed to create logger: %v", err)
+	}
+	slog.SetDefault(logger)
+	// secret di konfigurasi tercetak sebagai [REDACTED]
+	logger.Debug("configuration loaded", "config", config)
+
+	// 1. Koneksi database dan konfigurasi token
+	db, err := database.NewDB(config.DB)
+	if err != nil {
+		log.Fatalf("Failed to connect to the database: %v", err)
+	}
+
+	if migrateOnly {
+		err := runMigrate(context.Background(), db, os.Stdout, os.Args[2:])
+		db.Close()
+		if err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+
+	if config.DB.AutoMigrate {
+		if _, err := runMigrations(context.Background(), db); err != nil {
+			log.Fatalf("Failed to apply migrations: %v", err)
+		}
+	}
+
+	// 2. API WMS: repository, service, handler dan route disusun oleh package wms
+	app, err := wms.New(db, wms.Options{
+		JWTSecret:       []byte(config.Auth.JWTSecret.Reveal()),
+		Issuer:          config.Auth.Issuer,
+		AccessTokenTTL:  config.Auth.AccessTokenTTL,
+		RefreshTokenTTL: config.Auth.RefreshTokenTTL,
+		TxIsolation:     config.DB.TxIsolationLevel(),
+		TxMaxRetries:    txMaxRetries(config.DB.TxMaxRetries),
 	})
-	// 5. Tambahkan route untuk Swagger UI
-	// Ini adalah route yang Anda minta
+	if err != nil {
+		log.Fatalf("Failed to initialize WMS: %v", err)
+	}
+
+	// Password super admin awal dari WMS_BOOTSTRAP_ADMIN_PASSWORD, hanya dipasang
+	// selama SA-001 masih memakai placeholder dari migration
+	if password := config.Auth.BootstrapAdminPassword; password != "" {
+		set, err := app.BootstrapAdminPassword(context.Background(), password.Reveal())
+		if err != nil {
+			log.Fatalf("Failed to bootstrap admin password: %v", err)
+		}
+		if set {
+			logger.Info("bootstrap admin password set", "employee_code", service.BootstrapAdminCode)
+		} else {
+			logger.Info("bootstrap admin password skipped, password already set", "employee_code", service.BootstrapAdminCode)
+		}
+	}
+
+	// 3. Router
+	// RequestID dipasang pertama supaya semua log membawa request ID, AccessLog sebelum
+	// Recovery supaya request yang panic tetap tercatat sebagai 500. ErrorHandler tidak
+	// dipasang di sini karena sudah dipasang app.Register pada group route WMS.
+	r := gin.New()
+	r.Use(middleware.RequestID(), middleware.AccessLog(logger), middleware.Recovery(logger))
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	// (Perhatikan: kita TIDAK perlu mendaftarkan route API
-	//  seperti /api/v1/employees di sini.
-	//  swag init membaca anotasi dari SEMUA file .go Anda,
-	//  bukan hanya dari main.go)
+	app.Register(r.Group("/api/v1"))
+
+	srv := &http.Server{
+		Addr:              config.HTTP.Addr(),
+		Handler:           r,
+		ReadTimeout:       config.HTTP.ReadTimeout,
+		ReadHeaderTimeout: config.HTTP.ReadHeaderTimeout,
+		WriteTimeout:      config.HTTP.WriteTimeout,
+		IdleTimeout:       config.HTTP.IdleTimeout,
+	}
+
+	// 4. Jalankan server dan tunggu sinyal shutdown (SIGINT / SIGTERM)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		logger.Info("server listening", "addr", srv.Addr)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverErr <- err
+		}
+		close(serverErr)
+	}()
+
+	select {
+	case err := <-serverErr:
+		if err != nil {
+			logger.Error("server stopped unexpectedly", "error", err)
+		}
+	case <-ctx.Done():
+		logger.Info("shutdown signal received, draining in-flight requests")
+	}
+	stop()
+
+	// 5. Graceful shutdown: selesaikan request yang berjalan lalu tutup database
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.HTTP.ShutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.Error("failed to shutdown server gracefully", "error", err)
+	}
+
+	if err := db.Close(); err != nil {
+		logger.Error("failed to close database connection", "error", err)
+	}
+
+	logger.Info("server exited")
+}
 
-	// 6. Jalankan server
-	r.Run(":8080")
+// txMaxRetries menerjemahkan WMS_DB_TX_MAX_RETRIES ke wms.Options: di konfigurasi 0 berarti
+// tanpa percobaan ulang, sedangkan di wms.Options 0 berarti nilai default
+func txMaxRetries(retries int) int {
+	if retries == 0 {
+		return -1
+	}
+	return retries
 }
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/migrate"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/migrations"
+)
+
+const migrateUsage = `usage: wms migrate <command>
+
+commands:
+  up [N]       terapkan N migration berikutnya (default semua yang tertunda)
+  down N       batalkan N migration terakhir
+  status       tampilkan migration yang sudah dan belum diterapkan
+  force V      tandai migration sampai versi V sebagai sudah diterapkan tanpa menjalankan SQL`
+
+// runMigrate menjalankan subcommand migrate dengan argumen setelah kata "migrate"
+func runMigrate(ctx context.Context, db *sql.DB, out io.Writer, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing migrate command\n\n%s", migrateUsage)
+	}
+
+	migrator, err := migrate.New(db, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	switch cmd, rest := args[0], args[1:]; cmd {
+	case "up":
+		n := 0
+		if len(rest) > 0 {
+			if n, err = positiveArg(rest[0]); err != nil {
+				return err
+			}
+		}
+		done, err := migrator.Up(ctx, n)
+		printMigrations(out, "applied", done)
+		return err
+
+	case "down":
+		if len(rest) == 0 {
+			return fmt.Errorf("down requires the number of migrations to roll back\n\n%s", migrateUsage)
+		}
+		n, err := positiveArg(rest[0])
+		if err != nil {
+			return err
+		}
+		done, err := migrator.Down(ctx, n)
+		printMigrations(out, "rolled back", done)
+		return err
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		printStatus(out, statuses)
+		return nil
+
+	case "force":
+		if len(rest) == 0 {
+			return fmt.Errorf("force requires a version\n\n%s", migrateUsage)
+		}
+		version, err := strconv.ParseUint(rest[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", rest[0])
+		}
+		if err := migrator.Force(ctx, version); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "forced version %d\n", version)
+		return nil
+
+	default:
+		return fmt.Errorf("unknown migrate command %q\n\n%s", cmd, migrateUsage)
+	}
+}
+
+// runMigrations menerapkan semua migration yang tertunda, dipakai saat DB_AUTO_MIGRATE aktif.
+// Advisory lock membuat instance lain yang start bersamaan menunggu sampai selesai.
+func runMigrations(ctx context.Context, db *sql.DB) ([]migrate.Migration, error) {
+	migrator, err := migrate.New(db, migrations.FS)
+	if err != nil {
+		return nil, err
+	}
+	return migrator.Up(ctx, 0)
+}
+
+func positiveArg(raw string) (int, error) {
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid number %q, must be at least 1", raw)
+	}
+	return n, nil
+}
+
+func printMigrations(out io.Writer, verb string, done []migrate.Migration) {
+	if len(done) == 0 {
+		fmt.Fprintf(out, "no migrations %s\n", verb)
+		return
+	}
+	for _, m := range done {
+		fmt.Fprintf(out, "%s %06d_%s\n", verb, m.Version, m.Name)
+	}
+}
+
+func printStatus(out io.Writer, statuses []migrate.Status) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, s := range statuses {
+		status, appliedAt := "pending", ""
+		if s.Applied {
+			status, appliedAt = "applied", s.AppliedAt.Format("2006-01-02 15:04:05 MST")
+		}
+		if s.Missing {
+			status = "applied (file missing)"
+		}
+		fmt.Fprintf(w, "%06d\t%s\t%s\t%s\n", s.Version, s.Name, status, appliedAt)
+	}
+	w.Flush()
+}
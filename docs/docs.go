@@ -23,23 +23,82 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/employees": {
+        "/audit": {
             "get": {
-                "description": "Mengambil daftar employee yang difilter berdasarkan warehouse_code",
+                "description": "Mengambil catatan create/update/delete beserta snapshot sebelum dan sesudah perubahan. Default data terbaru dulu",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "audit"
                 ],
-                "summary": "Get Employee Berdasarkan Warehouse",
+                "summary": "Audit Trail",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Jumlah data per halaman (default 20, maksimal 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor halaman untuk pagination offset",
+                        "name": "page",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Kode Warehouse",
-                        "name": "warehouse_code",
-                        "in": "query",
-                        "required": true
+                        "description": "next_cursor dari response sebelumnya",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "id atau created_at; awali dengan - untuk urutan turun (default -id)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter employee_code yang melakukan perubahan",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter jenis data: employee, warehouse, category, size, product, inventory",
+                        "name": "entity_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter kunci data, misalnya employee_code atau id category",
+                        "name": "entity_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter aksi: create, update, delete",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter X-Request-ID request yang melakukan perubahan",
+                        "name": "request_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mulai waktu (RFC3339 atau YYYY-MM-DD), inklusif",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sampai waktu (RFC3339 atau YYYY-MM-DD), eksklusif",
+                        "name": "until",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -48,26 +107,39 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Employee"
+                                "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.AuditResponse"
                             }
                         }
                     },
                     "400": {
-                        "description": "Missing warehouse_code query",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     }
-                }
-            },
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/auth/login": {
             "post": {
-                "description": "Membuat employee baru dengan data yang diberikan",
+                "description": "Login dengan user_id atau employee_code dan password. Mengembalikan access token (JWT) dan refresh token",
                 "consumes": [
                     "application/json"
                 ],
@@ -75,271 +147,277 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "auth"
                 ],
-                "summary": "Buat Employee Baru",
+                "summary": "Login",
                 "parameters": [
                     {
-                        "description": "Data Employee Baru",
-                        "name": "employee",
+                        "description": "Data Login",
+                        "name": "login",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateEmployee"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.Login"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Employee"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TokenResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/employees/by-warehouse": {
-            "get": {
-                "description": "Mengambil daftar employee yang difilter berdasarkan warehouse_code",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "employees"
-                ],
-                "summary": "Get Employee Berdasarkan Warehouse",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Kode Warehouse",
-                        "name": "warehouse_code",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "401": {
+                        "description": "user_id/employee_code atau password salah",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Employee"
-                            }
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
-                    "400": {
-                        "description": "Missing warehouse_code query",
+                    "422": {
+                        "description": "Validasi gagal, data berisi daftar field yang tidak valid",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     }
                 }
             }
         },
-        "/employees/{id}": {
-            "get": {
-                "description": "Mengambil satu data employee berdasarkan ID",
+        "/auth/logout": {
+            "post": {
+                "description": "Mencabut access token yang sedang dipakai dan (opsional) seluruh sesi refresh token-nya",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "auth"
                 ],
-                "summary": "Get Employee Berdasarkan ID",
+                "summary": "Logout",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Employee ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Refresh token yang ikut dicabut",
+                        "name": "logout",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.Logout"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Employee"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
-                    "400": {
-                        "description": "ID tidak valid",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
-                    "404": {
-                        "description": "Employee tidak ditemukan",
+                    "422": {
+                        "description": "Validasi gagal, data berisi daftar field yang tidak valid",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     }
-                }
-            },
-            "delete": {
-                "description": "Menghapus data employee berdasarkan ID",
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "Menukar refresh token dengan pasangan token baru. Refresh token lama langsung tidak berlaku (rotasi)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "auth"
                 ],
-                "summary": "Hapus Employee",
+                "summary": "Refresh Token",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Employee ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Refresh Token",
+                        "name": "refresh",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.RefreshToken"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TokenResponse"
                         }
                     },
                     "400": {
-                        "description": "ID tidak valid",
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
-                    "404": {
-                        "description": "Employee tidak ditemukan",
+                    "401": {
+                        "description": "Refresh token tidak valid, kedaluwarsa atau sudah dicabut",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validasi gagal, data berisi daftar field yang tidak valid",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Memperbarui data employee (bisa sebagian) berdasarkan ID",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/barcodes/{barcode}": {
+            "get": {
+                "description": "Mencari product, size dan category dari barcode hasil scan",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "barcodes"
                 ],
-                "summary": "Update Employee (Parsial)",
+                "summary": "Scan Barcode",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Employee ID",
-                        "name": "id",
+                        "type": "string",
+                        "description": "Barcode",
+                        "name": "barcode",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Data update employee",
-                        "name": "employee",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UpdatedEmployee"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "ID atau data JSON tidak valid",
-                        "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.BarcodeLookupResponse"
                         }
                     },
                     "404": {
-                        "description": "Employee tidak ditemukan",
+                        "description": "Barcode tidak ditemukan",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     }
                 }
             }
         },
-        "/warehouses": {
+        "/category": {
             "get": {
-                "description": "Mengambil daftar semua warehouse",
+                "description": "Mengambil daftar category dengan pagination, sort dan filter",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "warehouses"
+                    "Categories"
+                ],
+                "summary": "Get Semua Category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Jumlah data per halaman (default 20, maksimal 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor halaman untuk pagination offset",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor dari response sebelumnya",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "id atau name; awali dengan - untuk urutan turun",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter nama category (mengandung)",
+                        "name": "name",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get Semua Warehouse",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Warehouse"
+                                "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.CategoryResponses"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Membuat warehouse baru",
+                "description": "Membuat category baru dengan data yang diberikan",
                 "consumes": [
                     "application/json"
                 ],
@@ -347,90 +425,2361 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "warehouses"
+                    "Categories"
                 ],
-                "summary": "Buat Warehouse Baru",
+                "summary": "Buat Category Baru",
                 "parameters": [
                     {
-                        "description": "Data Warehouse Baru",
-                        "name": "warehouse",
+                        "description": "Data Category Baru",
+                        "name": "Category",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateWarehouse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateCategory"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/models.Warehouse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validasi gagal, data berisi daftar field yang tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     }
                 }
             }
         },
-        "/warehouses/{id}": {
+        "/employees": {
             "get": {
-                "description": "Mengambil satu data warehouse berdasarkan ID",
+                "description": "Mengambil daftar employee dengan pagination, sort dan filter. Role tanpa akses semua warehouse hanya melihat employee di warehouse-nya",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "warehouses"
+                    "employees"
                 ],
-                "summary": "Get Warehouse Berdasarkan ID",
+                "summary": "Get Semua Employee",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Warehouse ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Jumlah data per halaman (default 20, maksimal 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor halaman untuk pagination offset",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor dari response sebelumnya",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "id, name atau code; awali dengan - untuk urutan turun",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter nama employee (mengandung)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter kode warehouse",
+                        "name": "warehouse_code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter ID Role",
+                        "name": "id_role",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Warehouse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.EmployeeResponse"
+                            }
                         }
                     },
                     "400": {
-                        "description": "ID tidak valid",
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
-                    "404": {
-                        "description": "Warehouse tidak ditemukan",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "504": {
+                        "description": "Gateway Timeout",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Menghapus data warehouse berdasarkan ID",
+            "post": {
+                "description": "Membuat employee baru dengan data yang diberikan",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Buat Employee Baru",
+                "parameters": [
+                    {
+                        "description": "Data Employee Baru",
+                        "name": "employee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateEmployee"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role memiliki permission yang tidak dimiliki user yang login",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validasi gagal, data berisi daftar field yang tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/by-warehouse/{id}": {
+            "get": {
+                "description": "Mengambil daftar employee yang difilter berdasarkan warehouse_code",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Get Employee Berdasarkan Warehouse",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kode Warehouse",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.EmployeeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Kode warehouse tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}": {
+            "get": {
+                "description": "Mengambil satu data employee berdasarkan ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Get Employee Berdasarkan ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.EmployeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Employee tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Menghapus data employee berdasarkan kode employee",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Hapus Employee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Employee Code",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Kode employee tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role employee memiliki permission yang tidak dimiliki user yang login",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Employee tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request dibatalkan oleh client",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Memperbarui data employee (bisa sebagian) berdasarkan kode employee",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Update Employee (Parsial)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Employee Code",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data update employee",
+                        "name": "employee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.UpdatedEmployee"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Kode employee atau data JSON tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role memiliki permission yang tidak dimiliki user yang login",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Employee tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validasi gagal, data berisi daftar field yang tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/adjustments": {
+            "post": {
+                "description": "Menambah atau mengurangi stok satu variant di satu warehouse secara atomik",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Penyesuaian Stok",
+                "parameters": [
+                    {
+                        "description": "Data penyesuaian stok",
+                        "name": "adjustment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.AdjustInventory"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.InventoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Variant tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Stok tidak mencukupi",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Warehouse tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/products/{code}": {
+            "get": {
+                "description": "Mengambil stok satu product di semua warehouse dan size",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Stok per Product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product Code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.InventoryResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/products/{code}/sizes/{id_size}": {
+            "get": {
+                "description": "Mengambil stok satu variant (product + size) di semua warehouse",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Stok per Variant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product Code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Size ID",
+                        "name": "id_size",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.InventoryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/warehouses/{code}": {
+            "get": {
+                "description": "Mengambil semua stok yang ada di satu warehouse",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Stok per Warehouse",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Warehouse Code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.InventoryResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/permissions": {
+            "get": {
+                "description": "Mengambil semua nama permission yang bisa diberikan ke role",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Daftar Permission",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/products": {
+            "get": {
+                "description": "Mengambil daftar product dengan pagination, sort dan filter",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get Semua Product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Jumlah data per halaman (default 20, maksimal 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor halaman untuk pagination offset",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor dari response sebelumnya",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "id, name, code atau price; awali dengan - untuk urutan turun",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter nama product (mengandung)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter kode product",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter ID Category",
+                        "name": "id_category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter nama category (mengandung)",
+                        "name": "category",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ProductResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Membuat product baru, product_code dibuat otomatis",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Buat Product Baru",
+                "parameters": [
+                    {
+                        "description": "Data Product Baru",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateProduct"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Category tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/code/{code}": {
+            "get": {
+                "description": "Mengambil satu data product berdasarkan product_code",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get Product Berdasarkan Product Code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product Code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ProductResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Product tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}": {
+            "get": {
+                "description": "Mengambil satu data product berdasarkan ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get Product Berdasarkan ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Product tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Menghapus product berdasarkan ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Hapus Product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Product tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Memperbarui data product (bisa sebagian) berdasarkan ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Update Product (Parsial)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data update product",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.UpdateProduct"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "ID atau data JSON tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Product tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Category tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/variants": {
+            "get": {
+                "description": "Mengambil semua variant (size + barcode) dari satu product",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get Variant Product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ProductDetailResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Product tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Menambahkan size variant ke product. Jika barcode kosong akan dibuat otomatis (EAN-13 atau Code128)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Tambah Variant Product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data Variant Baru",
+                        "name": "variant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateProductDetail"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ProductDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Product tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Variant atau barcode sudah ada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Barcode atau size tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/variants/{variant_id}": {
+            "delete": {
+                "description": "Menghapus satu variant dari product",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Hapus Variant Product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Variant ID",
+                        "name": "variant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Variant tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles": {
+            "get": {
+                "description": "Mengambil role beserta permission-nya dengan pagination, sort dan filter",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Daftar Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Jumlah data per halaman (default 20, maksimal 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor halaman untuk pagination offset",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor dari response sebelumnya",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "id atau name; awali dengan - untuk urutan turun",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter nama role (mengandung)",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.RoleResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Membuat role baru dengan daftar permission awal",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Buat Role Baru",
+                "parameters": [
+                    {
+                        "description": "Data Role Baru",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateRole"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.RoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission tidak dimiliki user yang login",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Nama role sudah dipakai",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Permission tidak dikenal",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/roles/{id}": {
+            "get": {
+                "description": "Mengambil satu role beserta permission-nya",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Detail Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Role",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.RoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Role tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Menghapus role. Role yang masih dipakai employee atau role super admin tidak bisa dihapus",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Hapus Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Role",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Role tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Role masih dipakai employee atau role dilindungi",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "patch": {
+                "description": "Mengganti nama role. Role super admin tidak bisa diganti namanya",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Ubah Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Role",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data Role",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.UpdateRole"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.RoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Role tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Nama role sudah dipakai atau role dilindungi",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validasi gagal, data berisi daftar field yang tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/roles/{id}/permissions": {
+            "put": {
+                "description": "Mengganti seluruh permission role dengan daftar yang dikirim. List kosong mencabut semua permission",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Atur Permission Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Role",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Daftar Permission",
+                        "name": "permissions",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.SetRolePermissions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.RoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission tidak dimiliki user yang login",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Role tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Permission tidak dikenal",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/size": {
+            "get": {
+                "description": "Mengambil daftar size dengan pagination, sort dan filter",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sizes"
+                ],
+                "summary": "Get Semua Size",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Jumlah data per halaman (default 20, maksimal 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor halaman untuk pagination offset",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor dari response sebelumnya",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "id atau name; awali dengan - untuk urutan turun",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter nama size (mengandung)",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.SizeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/inbound": {
+            "post": {
+                "description": "Membuat dokumen penerimaan barang (inbound) dengan status Pending",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Buat Penerimaan Barang",
+                "parameters": [
+                    {
+                        "description": "Data Penerimaan Barang",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateInboundTransaction"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Employee pencatat diambil dari access token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Warehouse atau variant tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/outbound": {
+            "post": {
+                "description": "Membuat dokumen pengiriman (outbound) dengan status Pending dan memesan stok warehouse asal",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Buat Pengiriman Barang",
+                "parameters": [
+                    {
+                        "description": "Data Pengiriman Barang",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateOutboundTransaction"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Employee pencatat diambil dari access token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Stok tidak mencukupi, data berisi kekurangan per baris",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Warehouse atau variant tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/transfer": {
+            "post": {
+                "description": "Membuat dokumen transfer dengan status Pending dan memesan stok warehouse asal",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Buat Transfer Antar Warehouse",
+                "parameters": [
+                    {
+                        "description": "Data Transfer",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateTransferTransaction"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Employee pencatat diambil dari access token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Stok tidak mencukupi, data berisi kekurangan per baris",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Warehouse atau variant tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{code}": {
+            "get": {
+                "description": "Mengambil dokumen transaksi beserta seluruh baris detailnya",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get Transaksi",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kode Transaksi",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaksi tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{code}/complete": {
+            "post": {
+                "description": "Menandai transaksi Completed dan memperbarui stok dalam satu transaksi database",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Selesaikan Transaksi",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kode Transaksi",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaksi tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Status transaksi tidak bisa diubah menjadi Completed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{code}/dispatch": {
+            "post": {
+                "description": "Mengurangi stok warehouse asal dan mengubah status transfer menjadi In Transit",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Kirim Transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kode Transaksi",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaksi tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transaksi tidak bisa dikirim dari status saat ini atau stok tidak mencukupi",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Transaksi bukan transfer",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{code}/receive": {
+            "post": {
+                "description": "Menambah stok warehouse tujuan sebanyak barang yang diterima (boleh sebagian). Transfer menjadi Completed jika semua barang sudah diterima",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Terima Transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kode Transaksi",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Barang yang diterima",
+                        "name": "receive",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.ReceiveTransfer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaksi tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transaksi tidak In Transit",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Variant tidak ada di dokumen atau jumlah melebihi sisa",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{code}/scan": {
+            "post": {
+                "description": "Mencocokkan barcode ke baris dokumen, menambah scanner_quantity dan mengembalikan progres scan seluruh dokumen",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Scan Barcode Transaksi",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kode Transaksi",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Barcode yang di-scan",
+                        "name": "scan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.ScanItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ScanProgressResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaksi atau barcode tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transaksi sudah selesai atau baris sudah ter-scan penuh",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Variant tidak ada di dokumen",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{code}/status": {
+            "patch": {
+                "description": "Memindahkan status transaksi sesuai alur yang sah (Pending ke Completed/Failed, In Transit ke Failed jika barang hilang). Transfer dikirim lewat /dispatch dan diselesaikan lewat /receive. Completed dan Failed tidak bisa diubah lagi",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Ubah Status Transaksi",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kode Transaksi",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status tujuan",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.ChangeTransactionStatus"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaksi tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Perpindahan status tidak sah, atau transfer harus lewat /dispatch dan /receive",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Status tidak dikenal",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/warehouses": {
+            "get": {
+                "description": "Mengambil daftar warehouse dengan pagination, sort dan filter",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "warehouses"
+                ],
+                "summary": "Get Semua Warehouse",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Jumlah data per halaman (default 20, maksimal 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor halaman untuk pagination offset",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor dari response sebelumnya",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "id, name atau code; awali dengan - untuk urutan turun",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter nama warehouse (mengandung)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter kode warehouse",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter lokasi (mengandung)",
+                        "name": "location",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.WarehouseResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Membuat warehouse baru",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "warehouses"
+                ],
+                "summary": "Buat Warehouse Baru",
+                "parameters": [
+                    {
+                        "description": "Data Warehouse Baru",
+                        "name": "warehouse",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateWarehouse"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validasi gagal, data berisi daftar field yang tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/warehouses/{id}": {
+            "delete": {
+                "description": "Menghapus data warehouse berdasarkan kode warehouse",
                 "produces": [
                     "application/json"
                 ],
@@ -440,8 +2789,8 @@ const docTemplate = `{
                 "summary": "Hapus Warehouse",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Warehouse ID",
+                        "type": "string",
+                        "description": "Warehouse Code",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -451,34 +2800,43 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "400": {
-                        "description": "ID tidak valid",
+                        "description": "Kode warehouse tidak valid",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "404": {
-                        "description": "Warehouse tidak ditemukan",
+                        "description": "Warehoouse tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request dibatalkan oleh client",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     }
                 }
             },
             "patch": {
-                "description": "Memperbarui data warehouse (bisa sebagian) berdasarkan ID",
+                "description": "Memperbarui data warehouse (bisa sebagian) berdasarkan kode warehouse",
                 "consumes": [
                     "application/json"
                 ],
@@ -491,8 +2849,8 @@ const docTemplate = `{
                 "summary": "Update Warehouse (Parsial)",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Warehouse ID",
+                        "type": "string",
+                        "description": "Warehouse Code",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -503,162 +2861,592 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateWarehouse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.UpdateWarehouse"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "400": {
-                        "description": "ID atau data JSON tidak valid",
+                        "description": "Kode warehouse atau data JSON tidak valid",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "404": {
-                        "description": "Warehouse tidak ditemukan",
+                        "description": "Employee tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validasi gagal, data berisi daftar field yang tidak valid",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/request.ErrorResponse"
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.Category": {
+        }
+    },
+    "definitions": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.AdjustInventory": {
+            "type": "object",
+            "required": [
+                "code_product",
+                "code_warehouse",
+                "id_size",
+                "quantity"
+            ],
+            "properties": {
+                "code_product": {
+                    "type": "string"
+                },
+                "code_warehouse": {
+                    "type": "string"
+                },
+                "id_size": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "quantity": {
+                    "description": "Quantity adalah selisih stok: positif untuk menambah, negatif untuk mengurangi",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.ChangeTransactionStatus": {
+            "type": "object",
+            "required": [
+                "id_status"
+            ],
+            "properties": {
+                "id_status": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateCategory": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 23,
+                    "minLength": 3
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateEmployee": {
+            "type": "object",
+            "required": [
+                "employee_name",
+                "id_role",
+                "password",
+                "warehouse_code"
+            ],
+            "properties": {
+                "employee_name": {
+                    "type": "string",
+                    "maxLength": 23,
+                    "minLength": 3
+                },
+                "id_role": {
+                    "type": "integer"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 23,
+                    "minLength": 8
+                },
+                "warehouse_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateInboundTransaction": {
+            "type": "object",
+            "required": [
+                "destination_warehouse_code",
+                "items",
+                "origin_entity_name"
+            ],
+            "properties": {
+                "destination_warehouse_code": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.TransactionItem"
+                    }
+                },
+                "origin_entity_name": {
+                    "description": "OriginEntityName adalah nama supplier / pengirim barang",
+                    "type": "string",
+                    "maxLength": 60,
+                    "minLength": 3
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateOutboundTransaction": {
+            "type": "object",
+            "required": [
+                "destination_entity_name",
+                "items",
+                "origin_warehouse_code"
+            ],
+            "properties": {
+                "destination_entity_name": {
+                    "description": "DestinationEntityName adalah nama customer / penerima barang",
+                    "type": "string",
+                    "maxLength": 60,
+                    "minLength": 3
+                },
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.TransactionItem"
+                    }
+                },
+                "origin_warehouse_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateProduct": {
+            "type": "object",
+            "required": [
+                "id_category",
+                "price",
+                "product_name"
+            ],
+            "properties": {
+                "description_product": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "id_category": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "product_name": {
+                    "type": "string",
+                    "maxLength": 60,
+                    "minLength": 3
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateProductDetail": {
+            "type": "object",
+            "required": [
+                "id_size"
+            ],
+            "properties": {
+                "barcode": {
+                    "description": "Barcode opsional, jika kosong akan dibuat otomatis sesuai BarcodeType",
+                    "type": "string",
+                    "maxLength": 48
+                },
+                "barcode_type": {
+                    "description": "BarcodeType: ean13 (default) atau code128",
+                    "type": "string",
+                    "enum": [
+                        "ean13",
+                        "code128"
+                    ]
+                },
+                "id_size": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateRole": {
+            "type": "object",
+            "required": [
+                "permissions",
+                "role_name"
+            ],
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "role_name": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 3
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateTransferTransaction": {
+            "type": "object",
+            "required": [
+                "destination_warehouse_code",
+                "items",
+                "origin_warehouse_code"
+            ],
+            "properties": {
+                "destination_warehouse_code": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.TransactionItem"
+                    }
+                },
+                "origin_warehouse_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.CreateWarehouse": {
+            "type": "object",
+            "required": [
+                "location_description",
+                "warehouse_name"
+            ],
+            "properties": {
+                "location_description": {
+                    "type": "string",
+                    "maxLength": 60,
+                    "minLength": 23
+                },
+                "warehouse_name": {
+                    "type": "string",
+                    "maxLength": 40,
+                    "minLength": 3
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.Login": {
+            "type": "object",
+            "required": [
+                "password"
+            ],
+            "properties": {
+                "employee_code": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.Logout": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.ReceiveTransfer": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.TransactionItem"
+                    }
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.RefreshToken": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.ScanItem": {
+            "type": "object",
+            "required": [
+                "barcode"
+            ],
+            "properties": {
+                "barcode": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.SetRolePermissions": {
+            "type": "object",
+            "required": [
+                "permissions"
+            ],
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.TransactionItem": {
+            "type": "object",
+            "required": [
+                "id_detail_product",
+                "quantity"
+            ],
+            "properties": {
+                "id_detail_product": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.UpdateProduct": {
             "type": "object",
             "properties": {
-                "id": {
+                "description_product": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "id_category": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "price": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "product_name": {
+                    "type": "string",
+                    "maxLength": 60,
+                    "minLength": 3
                 }
             }
         },
-        "models.Employee": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.UpdateRole": {
             "type": "object",
             "properties": {
-                "employee_code": {
-                    "type": "string"
+                "role_name": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 3
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.UpdateWarehouse": {
+            "type": "object",
+            "properties": {
+                "location_description": {
+                    "type": "string",
+                    "maxLength": 60
                 },
+                "warehouse_name": {
+                    "type": "string",
+                    "maxLength": 40,
+                    "minLength": 3
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_request.UpdatedEmployee": {
+            "type": "object",
+            "properties": {
                 "employee_name": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
+                    "type": "string",
+                    "maxLength": 23,
+                    "minLength": 3
                 },
                 "id_role": {
                     "type": "integer"
                 },
-                "role": {
-                    "description": "Relasi (Belongs To)",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.Role"
-                        }
-                    ]
+                "password": {
+                    "type": "string",
+                    "maxLength": 23,
+                    "minLength": 8
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ApiResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Code adalah kode error yang stabil untuk dibaca mesin, kosong jika request berhasil",
+                    "type": "string"
                 },
-                "user_id": {
+                "data": {},
+                "message": {
                     "type": "string"
                 },
-                "warehouse": {
-                    "$ref": "#/definitions/models.Warehouse"
+                "meta": {
+                    "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_pkg_response.Meta"
                 },
-                "warehouse_code": {
-                    "type": "string"
+                "status": {
+                    "type": "integer"
                 }
             }
         },
-        "models.Inventory": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.AuditResponse": {
             "type": "object",
             "properties": {
-                "code_product": {
+                "action": {
                     "type": "string"
                 },
-                "code_warehouse": {
+                "actor_employee_code": {
+                    "type": "string"
+                },
+                "after": {
+                    "type": "object"
+                },
+                "before": {
+                    "type": "object"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "diff": {
+                    "type": "object"
+                },
+                "entity_key": {
+                    "type": "string"
+                },
+                "entity_type": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "id_size": {
+                "request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.BarcodeLookupResponse": {
+            "type": "object",
+            "properties": {
+                "barcode": {
+                    "type": "string"
+                },
+                "category": {
+                    "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.CategoryResponses"
+                },
+                "id_detail_product": {
                     "type": "integer"
                 },
                 "product": {
-                    "description": "Relasi (Belongs To)",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.Product"
-                        }
-                    ]
+                    "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ProductResponse"
                 },
-                "quantity": {
+                "size": {
+                    "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.SizeResponse"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.CategoryResponses": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "integer"
                 },
-                "size": {
-                    "$ref": "#/definitions/models.Size"
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.EmployeeResponse": {
+            "type": "object",
+            "properties": {
+                "employee_code": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "integer"
                 },
-                "warehouse": {
-                    "$ref": "#/definitions/models.Warehouse"
+                "user_id": {
+                    "type": "string"
+                },
+                "warehouse_code": {
+                    "type": "string"
                 }
             }
         },
-        "models.Product": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.InventoryResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "description": "Relasi (Belongs To)",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.Category"
-                        }
-                    ]
+                "code_product": {
+                    "type": "string"
                 },
-                "description_product": {
-                    "description": "Diasumsikan bisa null",
+                "code_warehouse": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "id_category": {
+                "id_size": {
                     "type": "integer"
                 },
-                "price": {
+                "product_name": {
+                    "type": "string"
+                },
+                "quantity": {
                     "type": "integer"
                 },
-                "product_code": {
+                "size_name": {
                     "type": "string"
                 },
-                "product_details": {
-                    "description": "Relasi (Has Many)",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.ProductDetail"
-                    }
-                },
-                "product_name": {
+                "warehouse_name": {
                     "type": "string"
                 }
             }
         },
-        "models.ProductDetail": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ProductDetailResponse": {
             "type": "object",
             "properties": {
                 "barcode": {
@@ -673,81 +3461,113 @@ const docTemplate = `{
                 "id_size": {
                     "type": "integer"
                 },
-                "product": {
-                    "description": "Relasi (Belongs To)",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.Product"
-                        }
-                    ]
-                },
-                "size": {
-                    "$ref": "#/definitions/models.Size"
+                "size_name": {
+                    "type": "string"
                 }
             }
         },
-        "models.Role": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ProductResponse": {
             "type": "object",
             "properties": {
+                "category_name": {
+                    "type": "string"
+                },
+                "description_product": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "role_name": {
+                "id_category": {
+                    "type": "integer"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "product_code": {
+                    "type": "string"
+                },
+                "product_name": {
                     "type": "string"
                 }
             }
         },
-        "models.Size": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.RoleResponse": {
             "type": "object",
             "properties": {
                 "id": {
                     "type": "integer"
                 },
-                "name": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "role_name": {
                     "type": "string"
                 }
             }
         },
-        "models.Warehouse": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.ScanProgressResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
+                "code_transaksi": {
+                    "type": "string"
+                },
+                "complete": {
+                    "type": "boolean"
                 },
-                "inventories": {
-                    "description": "Relasi (Has Many)",
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Inventory"
+                        "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionItemResponse"
                     }
                 },
-                "location_description": {
-                    "type": "string"
+                "scanned": {
+                    "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionItemResponse"
                 },
-                "warehouse_code": {
-                    "type": "string"
+                "total_expected": {
+                    "type": "integer"
                 },
-                "warehouse_name": {
+                "total_scanned": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.SizeResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "request.CreateEmployee": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TokenResponse": {
             "type": "object",
-            "required": [
-                "employee_name",
-                "id_role",
-                "password",
-                "warehouse_code"
-            ],
             "properties": {
-                "employee_name": {
+                "access_token": {
                     "type": "string"
                 },
-                "id_role": {
+                "employee_code": {
+                    "type": "string"
+                },
+                "expires_in": {
                     "type": "integer"
                 },
-                "password": {
+                "refresh_expires_at": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "token_type": {
                     "type": "string"
                 },
                 "warehouse_code": {
@@ -755,60 +3575,117 @@ const docTemplate = `{
                 }
             }
         },
-        "request.CreateWarehouse": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionItemResponse": {
             "type": "object",
-            "required": [
-                "warehouse_code",
-                "warehouse_name"
-            ],
             "properties": {
-                "location_description": {
+                "barcode": {
                     "type": "string"
                 },
-                "warehouse_code": {
+                "code_product": {
                     "type": "string"
                 },
-                "warehouse_name": {
+                "id": {
+                    "type": "integer"
+                },
+                "id_detail_product": {
+                    "type": "integer"
+                },
+                "id_size": {
+                    "type": "integer"
+                },
+                "product_name": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "received_quantity": {
+                    "type": "integer"
+                },
+                "scanner_quantity": {
+                    "type": "integer"
+                },
+                "size_name": {
                     "type": "string"
                 }
             }
         },
-        "request.ErrorResponse": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionResponse": {
             "type": "object",
             "properties": {
-                "error": {
+                "code_transaksi": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "destination_entity_name": {
+                    "type": "string"
+                },
+                "employee_code": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "id_status": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.TransactionItemResponse"
+                    }
+                },
+                "origin_entity_name": {
+                    "type": "string"
+                },
+                "status_name": {
+                    "type": "string"
+                },
+                "tipe_transaksi": {
                     "type": "string"
                 }
             }
         },
-        "request.UpdateWarehouse": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_internal_dto_response.WarehouseResponse": {
             "type": "object",
             "properties": {
                 "location_description": {
                     "type": "string"
                 },
+                "warehouse_code": {
+                    "type": "string"
+                },
                 "warehouse_name": {
                     "type": "string"
                 }
             }
         },
-        "request.UpdatedEmployee": {
+        "github_com_AhmadKusumahDEV_Warehouse-Management-System_pkg_response.Meta": {
             "type": "object",
             "properties": {
-                "employee_name": {
-                    "type": "string"
-                },
-                "id_role": {
+                "limit": {
                     "type": "integer"
                 },
-                "password": {
+                "next_cursor": {
                     "type": "string"
                 },
-                "warehouse_code": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
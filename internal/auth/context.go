@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// key gin.Context untuk claims hasil verifikasi access token
+const claimsKey = "auth.claims"
+
+type claimsContextKey struct{}
+
+// SetClaims menyimpan claims employee yang sedang login ke gin.Context dan ke
+// context request, supaya layer service juga tahu siapa yang melakukan perubahan
+func SetClaims(c *gin.Context, claims *Claims) {
+	c.Set(claimsKey, claims)
+	c.Request = c.Request.WithContext(WithClaims(c.Request.Context(), claims))
+}
+
+// WithClaims menyimpan claims ke context, dipakai SetClaims dan pemanggil di luar HTTP request
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// ClaimsFrom mengambil claims employee yang sedang login dari gin.Context
+func ClaimsFrom(c *gin.Context) (*Claims, bool) {
+	value, ok := c.Get(claimsKey)
+	if !ok {
+		return nil, false
+	}
+
+	claims, ok := value.(*Claims)
+	return claims, ok
+}
+
+// ClaimsFromContext mengambil claims dari context request yang diisi SetClaims
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*Claims)
+	return claims, ok
+}
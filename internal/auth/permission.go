@@ -0,0 +1,70 @@
+package auth
+
+// Permission adalah nama aksi yang dicek oleh middleware Authorize.
+// Permission dimiliki role lewat tabel role_permission.
+type Permission string
+
+const (
+	PermEmployeeRead   Permission = "employee:read"
+	PermEmployeeWrite  Permission = "employee:write"
+	PermEmployeeDelete Permission = "employee:delete"
+
+	PermWarehouseRead   Permission = "warehouse:read"
+	PermWarehouseWrite  Permission = "warehouse:write"
+	PermWarehouseDelete Permission = "warehouse:delete"
+	// PermWarehouseAll mengizinkan akses data semua warehouse, bukan hanya warehouse milik employee
+	PermWarehouseAll Permission = "warehouse:all"
+
+	// category dan size
+	PermMasterRead   Permission = "master:read"
+	PermMasterWrite  Permission = "master:write"
+	PermMasterDelete Permission = "master:delete"
+
+	PermProductRead   Permission = "product:read"
+	PermProductWrite  Permission = "product:write"
+	PermProductDelete Permission = "product:delete"
+
+	PermInventoryRead   Permission = "inventory:read"
+	PermInventoryAdjust Permission = "inventory:adjust"
+
+	PermTransactionRead     Permission = "transaction:read"
+	PermTransactionCreate   Permission = "transaction:create"
+	PermTransactionScan     Permission = "transaction:scan"
+	PermTransactionDispatch Permission = "transaction:dispatch"
+	PermTransactionReceive  Permission = "transaction:receive"
+	PermTransactionComplete Permission = "transaction:complete"
+	PermTransactionStatus   Permission = "transaction:status"
+
+	PermRoleRead   Permission = "role:read"
+	PermRoleWrite  Permission = "role:write"
+	PermRoleDelete Permission = "role:delete"
+
+	PermAuditRead Permission = "audit:read"
+)
+
+// AllPermissions adalah daftar permission yang dikenal aplikasi
+var AllPermissions = []Permission{
+	PermEmployeeRead, PermEmployeeWrite, PermEmployeeDelete,
+	PermWarehouseRead, PermWarehouseWrite, PermWarehouseDelete, PermWarehouseAll,
+	PermMasterRead, PermMasterWrite, PermMasterDelete,
+	PermProductRead, PermProductWrite, PermProductDelete,
+	PermInventoryRead, PermInventoryAdjust,
+	PermTransactionRead, PermTransactionCreate, PermTransactionScan, PermTransactionDispatch,
+	PermTransactionReceive, PermTransactionComplete, PermTransactionStatus,
+	PermRoleRead, PermRoleWrite, PermRoleDelete,
+	PermAuditRead,
+}
+
+// RoleSuperAdmin selalu memiliki semua permission supaya tidak ada yang terkunci
+// di luar sistem karena permission role terhapus
+const RoleSuperAdmin = "super admin"
+
+// IsKnownPermission mengecek apakah p terdaftar di AllPermissions
+func IsKnownPermission(p Permission) bool {
+	for _, known := range AllPermissions {
+		if known == p {
+			return true
+		}
+	}
+	return false
+}
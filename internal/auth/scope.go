@@ -0,0 +1,54 @@
+package auth
+
+import "context"
+
+// WarehouseHeader dipakai admin dan super admin untuk memilih warehouse yang sedang dikelola
+const WarehouseHeader = "X-Warehouse-Code"
+
+type scopeKey struct{}
+
+// WarehouseScope adalah batas warehouse yang boleh diakses oleh request.
+// All bernilai true jika request boleh mengakses semua warehouse. Zero value
+// tidak boleh mengakses warehouse mana pun.
+type WarehouseScope struct {
+	All           bool
+	WarehouseCode string
+}
+
+// Allows mengecek apakah data milik warehouse code boleh diakses
+func (s WarehouseScope) Allows(code string) bool {
+	return s.All || (s.WarehouseCode != "" && s.WarehouseCode == code)
+}
+
+// ResolveScope menentukan scope warehouse dari claims dan header X-Warehouse-Code.
+// Role tanpa permission warehouse:all selalu dibatasi ke warehouse-nya sendiri dan
+// hanya boleh mengirim header yang sama dengan warehouse-nya. Role dengan
+// warehouse:all (admin dan super admin) bisa mengakses semua warehouse, atau satu
+// warehouse jika header diisi.
+func ResolveScope(claims *Claims, header string, allWarehouses bool) (WarehouseScope, bool) {
+	if allWarehouses {
+		if header == "" {
+			return WarehouseScope{All: true}, true
+		}
+		return WarehouseScope{WarehouseCode: header}, true
+	}
+
+	if header != "" && header != claims.WarehouseCode {
+		return WarehouseScope{}, false
+	}
+	return WarehouseScope{WarehouseCode: claims.WarehouseCode}, true
+}
+
+// WithScope menyimpan scope warehouse ke context request
+func WithScope(ctx context.Context, scope WarehouseScope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// ScopeFrom mengambil scope warehouse dari context. Context tanpa scope tidak boleh
+// mengakses warehouse mana pun, sehingga route yang lupa memasang ScopeWarehouse
+// gagal tertutup. Pemanggil internal yang dipercaya (job, CLI, test) harus memasang
+// WithScope(ctx, WarehouseScope{All: true}) secara eksplisit.
+func ScopeFrom(ctx context.Context) WarehouseScope {
+	scope, _ := ctx.Value(scopeKey{}).(WarehouseScope)
+	return scope
+}
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	database "github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/config"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+	"github.com/gofrs/uuid"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrInvalidToken dikembalikan untuk token yang rusak, salah tanda tangan atau sudah kedaluwarsa
+var ErrInvalidToken = apperror.Unauthorized("invalid_token", "invalid or expired token")
+
+// panjang refresh token acak dalam byte sebelum di-encode
+const refreshTokenBytes = 32
+
+// Claims adalah isi access token
+type Claims struct {
+	EmployeeCode  string `json:"employee_code"`
+	IDRole        uint   `json:"id_role"`
+	Role          string `json:"role"`
+	WarehouseCode string `json:"warehouse_code"`
+	jwt.RegisteredClaims
+}
+
+// TokenManager membuat dan memverifikasi token
+type TokenManager struct {
+	secret     []byte
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+func NewTokenManager(config database.AuthConfig) *TokenManager {
+	return &TokenManager{
+		secret:     []byte(config.JWTSecret.Reveal()),
+		issuer:     config.Issuer,
+		accessTTL:  config.AccessTokenTTL,
+		refreshTTL: config.RefreshTokenTTL,
+	}
+}
+
+// AccessTTL adalah masa berlaku access token
+func (m *TokenManager) AccessTTL() time.Duration {
+	return m.accessTTL
+}
+
+// RefreshTTL adalah masa berlaku refresh token
+func (m *TokenManager) RefreshTTL() time.Duration {
+	return m.refreshTTL
+}
+
+// IssueAccessToken membuat access token (HS256) untuk employee.
+// Employee harus sudah berisi Role.RoleName.
+func (m *TokenManager) IssueAccessToken(emp *models.Employee) (string, *Claims, error) {
+	jti, err := uuid.NewV4()
+	if err != nil {
+		return "", nil, err
+	}
+
+	now := time.Now()
+	claims := &Claims{
+		EmployeeCode:  emp.EmployeeCode,
+		IDRole:        emp.IDRole,
+		Role:          emp.Role.RoleName,
+		WarehouseCode: emp.WarehouseCode,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti.String(),
+			Issuer:    m.issuer,
+			Subject:   emp.EmployeeCode,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.accessTTL)),
+		},
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return signed, claims, nil
+}
+
+// ParseAccessToken memverifikasi tanda tangan, issuer dan masa berlaku access token
+func (m *TokenManager) ParseAccessToken(token string) (*Claims, error) {
+	claims := &Claims{}
+
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return m.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// NewRefreshToken membuat refresh token acak. Token asli diberikan ke client,
+// sedangkan yang disimpan di database hanya hash-nya.
+func NewRefreshToken() (token string, hash string, err error) {
+	buf := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(buf)
+	return token, HashRefreshToken(token), nil
+}
+
+// HashRefreshToken menghasilkan hash SHA-256 (hex) dari refresh token
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
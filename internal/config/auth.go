@@ -0,0 +1,52 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// panjang minimal secret HMAC untuk tanda tangan JWT (HS256)
+const minJWTSecretLength = 32
+
+// batas panjang password sama dengan aturan password employee di request
+const (
+	minPasswordLength = 8
+	maxPasswordLength = 23
+)
+
+// AuthConfig holds token signing configuration
+type AuthConfig struct {
+	JWTSecret       Secret
+	Issuer          string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	// BootstrapAdminPassword di-hash dan dipasang ke super admin SA-001 saat start,
+	// hanya selama password SA-001 masih placeholder dari migration 000003
+	BootstrapAdminPassword Secret
+}
+
+// DefaultAuthConfig returns an AuthConfig with default values.
+// JWTSecret sengaja tidak punya nilai default supaya token tidak pernah
+// ditandatangani dengan secret yang diketahui umum.
+func DefaultAuthConfig() AuthConfig {
+	return AuthConfig{
+		Issuer:          "wms",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 7 * 24 * time.Hour,
+	}
+}
+
+func (c AuthConfig) Validate() error {
+	var errs []error
+	if len(c.JWTSecret) < minJWTSecretLength {
+		errs = append(errs, fmt.Errorf("%sJWT_SECRET must be at least %d bytes", EnvPrefix, minJWTSecretLength))
+	}
+	if c.AccessTokenTTL <= 0 || c.RefreshTokenTTL <= 0 {
+		errs = append(errs, fmt.Errorf("%sJWT_ACCESS_TTL and %sJWT_REFRESH_TTL must be greater than 0", EnvPrefix, EnvPrefix))
+	}
+	if n := len(c.BootstrapAdminPassword); n > 0 && (n < minPasswordLength || n > maxPasswordLength) {
+		errs = append(errs, fmt.Errorf("%sBOOTSTRAP_ADMIN_PASSWORD must be between %d and %d characters", EnvPrefix, minPasswordLength, maxPasswordLength))
+	}
+	return errors.Join(errs...)
+}
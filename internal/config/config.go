@@ -0,0 +1,281 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/logging"
+	"github.com/joho/godotenv"
+)
+
+const (
+	// EnvPrefix adalah awalan semua variabel konfigurasi, supaya tidak bentrok dengan
+	// variabel shell seperti $USER atau $PORT
+	EnvPrefix = "WMS_"
+
+	// ConfigFileEnv menunjuk file konfigurasi; jika kosong DefaultConfigFile dipakai bila ada
+	ConfigFileEnv     = EnvPrefix + "CONFIG_FILE"
+	DefaultConfigFile = "configs/local.env"
+)
+
+// Config adalah seluruh konfigurasi aplikasi. Dibuat sekali di main lalu
+// diteruskan ke komponen yang membutuhkan.
+type Config struct {
+	HTTP HTTPConfig
+	DB   DBConfig
+	Auth AuthConfig
+	Log  LogConfig
+}
+
+// Default returns a Config with default values
+func Default() Config {
+	return Config{
+		HTTP: DefaultHTTPConfig(),
+		DB:   DefaultDBConfig(),
+		Auth: DefaultAuthConfig(),
+		Log:  DefaultLogConfig(),
+	}
+}
+
+// Load membaca konfigurasi dari file format KEY=VALUE di path lalu dari variabel
+// environment WMS_*. Variabel environment menimpa isi file. Jika path kosong,
+// DefaultConfigFile dibaca hanya jika file tersebut ada.
+//
+// Load hanya menolak nilai yang tidak bisa dibaca atau key file yang tidak dikenal;
+// panggil Validate (atau Validate milik bagian yang dipakai) sebelum konfigurasi dipakai.
+func Load(path string) (Config, error) {
+	config := Default()
+
+	file, err := readConfigFile(path)
+	if err != nil {
+		return config, err
+	}
+
+	src := &source{file: file, known: map[string]bool{}}
+
+	src.int("HTTP_PORT", &config.HTTP.Port)
+	src.duration("HTTP_READ_TIMEOUT", &config.HTTP.ReadTimeout)
+	src.duration("HTTP_READ_HEADER_TIMEOUT", &config.HTTP.ReadHeaderTimeout)
+	src.duration("HTTP_WRITE_TIMEOUT", &config.HTTP.WriteTimeout)
+	src.duration("HTTP_IDLE_TIMEOUT", &config.HTTP.IdleTimeout)
+	src.duration("HTTP_SHUTDOWN_TIMEOUT", &config.HTTP.ShutdownTimeout)
+
+	src.secret("DB_DSN", &config.DB.DSN)
+	src.string("DB_HOST", &config.DB.Host)
+	src.string("DB_PORT", &config.DB.Port)
+	src.string("DB_USER", &config.DB.User)
+	src.secret("DB_PASSWORD", &config.DB.Password)
+	src.string("DB_NAME", &config.DB.DBName)
+	src.string("DB_SSL_MODE", &config.DB.SSLMode)
+	src.int("DB_MAX_IDLE_CONNS", &config.DB.MaxIdleConns)
+	src.int("DB_MAX_OPEN_CONNS", &config.DB.MaxOpenConns)
+	src.duration("DB_CONN_MAX_LIFETIME", &config.DB.ConnMaxLifetime)
+	src.duration("DB_CONN_MAX_IDLE_TIME", &config.DB.ConnMaxIdleTime)
+	src.bool("DB_AUTO_MIGRATE", &config.DB.AutoMigrate)
+	src.string("DB_TX_ISOLATION", &config.DB.TxIsolation)
+	src.int("DB_TX_MAX_RETRIES", &config.DB.TxMaxRetries)
+
+	src.secret("JWT_SECRET", &config.Auth.JWTSecret)
+	src.string("JWT_ISSUER", &config.Auth.Issuer)
+	src.duration("JWT_ACCESS_TTL", &config.Auth.AccessTokenTTL)
+	src.duration("JWT_REFRESH_TTL", &config.Auth.RefreshTokenTTL)
+	src.secret("BOOTSTRAP_ADMIN_PASSWORD", &config.Auth.BootstrapAdminPassword)
+
+	src.string("LOG_FORMAT", &config.Log.Format)
+	src.level("LOG_LEVEL", &config.Log.Level)
+
+	src.unknownFileKeys()
+	return config, errors.Join(src.errs...)
+}
+
+// Validate memeriksa semua bagian konfigurasi dan mengembalikan semua kesalahan sekaligus
+func (c Config) Validate() error {
+	return errors.Join(c.HTTP.Validate(), c.DB.Validate(), c.Auth.Validate(), c.Log.Validate())
+}
+
+// HTTPConfig holds HTTP server configuration
+type HTTPConfig struct {
+	Port              int
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	// ShutdownTimeout adalah lama waktu maksimal menunggu request yang sedang berjalan saat shutdown
+	ShutdownTimeout time.Duration
+}
+
+// DefaultHTTPConfig returns an HTTPConfig with default values
+func DefaultHTTPConfig() HTTPConfig {
+	return HTTPConfig{
+		Port:              8080,
+		ReadTimeout:       30 * time.Second,
+		ReadHeaderTimeout: 10 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       120 * time.Second,
+		ShutdownTimeout:   15 * time.Second,
+	}
+}
+
+// Addr adalah alamat listen server, misalnya ":8080"
+func (c HTTPConfig) Addr() string {
+	return ":" + strconv.Itoa(c.Port)
+}
+
+func (c HTTPConfig) Validate() error {
+	var errs []error
+	if c.Port < 1 || c.Port > 65535 {
+		errs = append(errs, fmt.Errorf("%sHTTP_PORT must be between 1 and 65535", EnvPrefix))
+	}
+	for key, d := range map[string]time.Duration{
+		"HTTP_READ_TIMEOUT":        c.ReadTimeout,
+		"HTTP_READ_HEADER_TIMEOUT": c.ReadHeaderTimeout,
+		"HTTP_WRITE_TIMEOUT":       c.WriteTimeout,
+		"HTTP_IDLE_TIMEOUT":        c.IdleTimeout,
+		"HTTP_SHUTDOWN_TIMEOUT":    c.ShutdownTimeout,
+	} {
+		if d <= 0 {
+			errs = append(errs, fmt.Errorf("%s%s must be greater than 0", EnvPrefix, key))
+		}
+	}
+	sortErrors(errs)
+	return errors.Join(errs...)
+}
+
+// Secret menyimpan nilai rahasia seperti password atau JWT secret. Nilainya disamarkan
+// saat dicetak dengan fmt, ditulis ke log atau di-encode ke JSON; gunakan Reveal untuk
+// mengambil nilai aslinya.
+type Secret string
+
+const redacted = "[REDACTED]"
+
+func (s Secret) Reveal() string { return string(s) }
+
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+func (s Secret) GoString() string             { return strconv.Quote(s.String()) }
+func (s Secret) LogValue() slog.Value         { return slog.StringValue(s.String()) }
+func (s Secret) MarshalText() ([]byte, error) { return []byte(s.String()), nil }
+
+// source membaca nilai dari environment lalu dari file; kesalahan parsing dikumpulkan
+// supaya semua nilai yang salah dilaporkan sekaligus
+type source struct {
+	file  map[string]string
+	known map[string]bool
+	errs  []error
+}
+
+func readConfigFile(path string) (map[string]string, error) {
+	if path == "" {
+		path = DefaultConfigFile
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			return map[string]string{}, nil
+		}
+	}
+
+	file, err := godotenv.Read(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config file %s: %w", path, err)
+	}
+	return file, nil
+}
+
+func (s *source) lookup(key string) (string, bool) {
+	key = EnvPrefix + key
+	s.known[key] = true
+
+	if value, ok := os.LookupEnv(key); ok {
+		return value, true
+	}
+	value, ok := s.file[key]
+	return value, ok
+}
+
+func (s *source) fail(key string, err error) {
+	s.errs = append(s.errs, fmt.Errorf("invalid %s%s: %w", EnvPrefix, key, err))
+}
+
+func (s *source) string(key string, dst *string) {
+	if value, ok := s.lookup(key); ok {
+		*dst = value
+	}
+}
+
+func (s *source) secret(key string, dst *Secret) {
+	if value, ok := s.lookup(key); ok {
+		*dst = Secret(value)
+	}
+}
+
+func (s *source) int(key string, dst *int) {
+	if value, ok := s.lookup(key); ok {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			s.fail(key, err)
+			return
+		}
+		*dst = n
+	}
+}
+
+func (s *source) bool(key string, dst *bool) {
+	if value, ok := s.lookup(key); ok {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			s.fail(key, err)
+			return
+		}
+		*dst = b
+	}
+}
+
+func (s *source) duration(key string, dst *time.Duration) {
+	if value, ok := s.lookup(key); ok {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			s.fail(key, err)
+			return
+		}
+		*dst = d
+	}
+}
+
+func (s *source) level(key string, dst *slog.Level) {
+	if value, ok := s.lookup(key); ok {
+		level, err := logging.ParseLevel(value)
+		if err != nil {
+			s.fail(key, err)
+			return
+		}
+		*dst = level
+	}
+}
+
+// unknownFileKeys menolak key di file yang tidak dikenal, misalnya salah ketik atau
+// nama lama tanpa awalan WMS_ seperti HOST dan PASSWORD
+func (s *source) unknownFileKeys() {
+	var keys []string
+	for key := range s.file {
+		if !s.known[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		s.errs = append(s.errs, fmt.Errorf("unknown config key %s", key))
+	}
+}
+
+func sortErrors(errs []error) {
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+}
@@ -1,123 +1,122 @@
 package database
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"log"
-	"os"
-	"strconv"
+	"log/slog"
+	"strings"
 	"time"
 
-	"github.com/joho/godotenv"
-	_ "github.com/lib/pq" // PostgreSQL driver
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/postgres"
 )
 
-// DBConfig holds database configuration parameters
+// DBConfig holds database configuration parameters.
+// Jika DSN diisi, Host, Port, User, Password, DBName dan SSLMode diabaikan.
 type DBConfig struct {
+	DSN             Secret
 	Host            string
 	Port            string
 	User            string
-	Password        string
+	Password        Secret
 	DBName          string
 	MaxIdleConns    int
 	MaxOpenConns    int
 	ConnMaxLifetime time.Duration
 	ConnMaxIdleTime time.Duration
 	SSLMode         string
+	// AutoMigrate menjalankan migrate up saat server start
+	AutoMigrate bool
+	// TxIsolation adalah isolation level transaksi multi-repository, misalnya
+	// "read committed" atau "serializable"; kosong berarti default database
+	TxIsolation string
+	// TxMaxRetries adalah jumlah percobaan ulang transaksi setelah serialization failure
+	TxMaxRetries int
 }
 
 // DefaultDBConfig returns a DBConfig with default values
 func DefaultDBConfig() DBConfig {
 	return DBConfig{
+		Host:            "localhost",
+		Port:            "5432",
+		User:            "postgres",
+		DBName:          "postgres",
 		MaxIdleConns:    5,
 		MaxOpenConns:    20,
 		ConnMaxLifetime: 60 * time.Minute,
 		ConnMaxIdleTime: 10 * time.Minute,
 		SSLMode:         "disable", // Use "require" or "verify-full" in production
+		TxMaxRetries:    3,
 	}
 }
 
-// NewDB creates a new database connection with enhanced configuration
-func NewDB() *sql.DB {
-	// Load environment variables
-	config, err := loadDBConfig()
-
-	if err != nil {
-		log.Fatalf("Failed to load database configuration: %v", err)
+func (c DBConfig) Validate() error {
+	var errs []error
+	if c.DSN == "" && (c.Host == "" || c.User == "" || c.DBName == "") {
+		errs = append(errs, fmt.Errorf("%sDB_DSN or %sDB_HOST, %sDB_USER and %sDB_NAME are required", EnvPrefix, EnvPrefix, EnvPrefix, EnvPrefix))
 	}
-
-	// Create connection string
-	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
-		config.Host, config.Port, config.User, config.Password, config.DBName, config.SSLMode)
-
-	// Open database connection
-	db, err := sql.Open("postgres", connStr)
-	if err != nil {
-		log.Fatalf("Failed to open database connection: %v", err)
+	if c.MaxOpenConns < 0 || c.MaxIdleConns < 0 {
+		errs = append(errs, fmt.Errorf("%sDB_MAX_OPEN_CONNS and %sDB_MAX_IDLE_CONNS must not be negative", EnvPrefix, EnvPrefix))
 	}
-
-	// Set connection pool settings
-	db.SetMaxIdleConns(config.MaxIdleConns)
-	db.SetMaxOpenConns(config.MaxOpenConns)
-	db.SetConnMaxLifetime(config.ConnMaxLifetime)
-	db.SetConnMaxIdleTime(config.ConnMaxIdleTime)
-
-	// Verify connection
-	if err := db.Ping(); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+	if c.MaxOpenConns > 0 && c.MaxIdleConns > c.MaxOpenConns {
+		errs = append(errs, fmt.Errorf("%sDB_MAX_IDLE_CONNS must not exceed %sDB_MAX_OPEN_CONNS", EnvPrefix, EnvPrefix))
 	}
-
-	log.Println("Successfully connected to the database")
-	return db
-}
-
-// loadDBConfig loads database configuration from environment variables
-func loadDBConfig() (DBConfig, error) {
-	config := DefaultDBConfig()
-
-	// Try to load .env file, but don't fail if it doesn't exist
-	// This allows environment variables to be set directly (e.g., in production)
-	err := godotenv.Load("configs/local.env")
-	if err != nil {
-		log.Printf("Failed to load .env file: %v", err)
+	if _, err := postgres.ParseIsolationLevel(c.TxIsolation); err != nil {
+		errs = append(errs, fmt.Errorf("%sDB_TX_ISOLATION: %w", EnvPrefix, err))
+	}
+	if c.TxMaxRetries < 0 {
+		errs = append(errs, fmt.Errorf("%sDB_TX_MAX_RETRIES must not be negative", EnvPrefix))
 	}
+	return errors.Join(errs...)
+}
 
-	// Get required environment variables
-	config.Host = getEnv("HOST", "localhost")
-	config.Port = getEnv("PORT", "5432")
-	config.User = getEnv("USER", "postgres")
-	config.Password = getEnv("PASSWORD", "postgres")
-	config.DBName = getEnv("NAME", "postgres")
+// TxIsolationLevel mengembalikan TxIsolation yang sudah di-parse. Nilai yang tidak
+// valid sudah ditolak Validate sehingga di sini dianggap default.
+func (c DBConfig) TxIsolationLevel() sql.IsolationLevel {
+	level, _ := postgres.ParseIsolationLevel(c.TxIsolation)
+	return level
+}
 
-	fmt.Println(config.Password)
-	// Get optional environment variables with defaults
-	if maxIdle := getEnv("DB_MAX_IDLE_CONNS", ""); maxIdle != "" {
-		if val, err := strconv.Atoi(maxIdle); err == nil {
-			config.MaxIdleConns = val
-		}
+// ConnString mengembalikan connection string untuk driver postgres, termasuk password.
+// Jangan ditulis ke log.
+func (c DBConfig) ConnString() string {
+	if c.DSN != "" {
+		return c.DSN.Reveal()
 	}
 
-	if maxOpen := getEnv("DB_MAX_OPEN_CONNS", ""); maxOpen != "" {
-		if val, err := strconv.Atoi(maxOpen); err == nil {
-			config.MaxOpenConns = val
-		}
+	parts := []string{
+		"host=" + quoteConnValue(c.Host),
+		"port=" + quoteConnValue(c.Port),
+		"user=" + quoteConnValue(c.User),
+		"dbname=" + quoteConnValue(c.DBName),
+		"sslmode=" + quoteConnValue(c.SSLMode),
 	}
-
-	if sslMode := getEnv("DB_SSL_MODE", ""); sslMode != "" {
-		config.SSLMode = sslMode
+	if c.Password != "" {
+		parts = append(parts, "password="+quoteConnValue(c.Password.Reveal()))
 	}
+	return strings.Join(parts, " ")
+}
 
-	// Validate required configuration
-	if config.User == "" || config.Password == "" || config.DBName == "" {
-		return config, fmt.Errorf("database user, password, and name are required")
-	}
-	return config, nil
+// quoteConnValue mengutip nilai connection string supaya spasi atau tanda kutip
+// di password tidak merusak format key=value
+func quoteConnValue(value string) string {
+	return `'` + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + `'`
 }
 
-// getEnv gets an environment variable or returns a default value
-func getEnv(key, defaultValue string) string {
-	if value, exists := os.LookupEnv(key); exists {
-		return value
+// NewDB creates a new database connection with the given configuration
+func NewDB(config DBConfig) (*sql.DB, error) {
+	db, err := postgres.Open(context.Background(), postgres.Config{
+		DSN:             config.ConnString(),
+		MaxIdleConns:    config.MaxIdleConns,
+		MaxOpenConns:    config.MaxOpenConns,
+		ConnMaxLifetime: config.ConnMaxLifetime,
+		ConnMaxIdleTime: config.ConnMaxIdleTime,
+	})
+	if err != nil {
+		return nil, err
 	}
-	return defaultValue
+
+	slog.Info("successfully connected to the database")
+	return db, nil
 }
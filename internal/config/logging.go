@@ -0,0 +1,32 @@
+package database
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/logging"
+)
+
+// LogConfig holds structured logging configuration.
+// Format bernilai json atau text, Level bernilai debug, info, warn atau error.
+type LogConfig struct {
+	Format string
+	Level  slog.Level
+}
+
+// DefaultLogConfig returns a LogConfig with default values
+func DefaultLogConfig() LogConfig {
+	return LogConfig{
+		Format: logging.FormatJSON,
+		Level:  slog.LevelInfo,
+	}
+}
+
+func (c LogConfig) Validate() error {
+	switch strings.ToLower(c.Format) {
+	case logging.FormatJSON, logging.FormatText:
+		return nil
+	}
+	return fmt.Errorf("%sLOG_FORMAT must be %s or %s", EnvPrefix, logging.FormatJSON, logging.FormatText)
+}
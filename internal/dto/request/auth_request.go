@@ -0,0 +1,17 @@
+package request
+
+// Login menerima user_id atau employee_code, salah satu wajib diisi
+type Login struct {
+	UserID       string `json:"user_id" binding:"required_without=EmployeeCode"`
+	EmployeeCode string `json:"employee_code" binding:"required_without=UserID"`
+	Password     string `json:"password" binding:"required"`
+}
+
+type RefreshToken struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// Logout boleh menyertakan refresh token supaya sesi (family) refresh token ikut dicabut
+type Logout struct {
+	RefreshToken string `json:"refresh_token"`
+}
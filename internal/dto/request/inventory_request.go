@@ -0,0 +1,9 @@
+package request
+
+type AdjustInventory struct {
+	CodeProduct   string `json:"code_product" binding:"required"`
+	IDSize        int    `json:"id_size" binding:"required,min=1"`
+	CodeWarehouse string `json:"code_warehouse" binding:"required,warehouse_code"`
+	// Quantity adalah selisih stok: positif untuk menambah, negatif untuk mengurangi
+	Quantity int `json:"quantity" binding:"required"`
+}
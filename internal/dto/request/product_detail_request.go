@@ -0,0 +1,9 @@
+package request
+
+type CreateProductDetail struct {
+	IDSize int `json:"id_size" binding:"required,min=1"`
+	// Barcode opsional, jika kosong akan dibuat otomatis sesuai BarcodeType
+	Barcode string `json:"barcode" binding:"omitempty,max=48,barcode=BarcodeType"`
+	// BarcodeType: ean13 (default) atau code128
+	BarcodeType string `json:"barcode_type" binding:"omitempty,oneof=ean13 code128"`
+}
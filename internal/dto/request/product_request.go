@@ -0,0 +1,15 @@
+package request
+
+type CreateProduct struct {
+	ProductName        string `json:"product_name" binding:"required,min=3,max=60"`
+	Price              int    `json:"price" binding:"required,positive"`
+	DescriptionProduct string `json:"description_product" binding:"max=255"`
+	IDCategory         int    `json:"id_category" binding:"required,min=1"`
+}
+
+type UpdateProduct struct {
+	ProductName        *string `json:"product_name" binding:"omitempty,min=3,max=60"`
+	Price              *int    `json:"price" binding:"omitempty,positive"`
+	DescriptionProduct *string `json:"description_product" binding:"omitempty,max=255"`
+	IDCategory         *int    `json:"id_category" binding:"omitempty,min=1"`
+}
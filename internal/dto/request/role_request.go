@@ -0,0 +1,15 @@
+package request
+
+type CreateRole struct {
+	RoleName    string   `json:"role_name" binding:"required,min=3,max=30"`
+	Permissions []string `json:"permissions" binding:"omitempty,dive,required"`
+}
+
+type UpdateRole struct {
+	RoleName *string `json:"role_name" binding:"omitempty,min=3,max=30"`
+}
+
+// SetRolePermissions mengganti seluruh permission role; list kosong mencabut semua permission
+type SetRolePermissions struct {
+	Permissions []string `json:"permissions" binding:"required,dive,required"`
+}
@@ -0,0 +1,43 @@
+package request
+
+type TransactionItem struct {
+	IDDetailProduct int `json:"id_detail_product" binding:"required,min=1"`
+	Quantity        int `json:"quantity" binding:"required,positive"`
+}
+
+// CreateInboundTransaction dan dokumen create lainnya tidak berisi employee_code;
+// pencatat dokumen selalu employee pemilik access token
+type CreateInboundTransaction struct {
+	// OriginEntityName adalah nama supplier / pengirim barang
+	OriginEntityName         string            `json:"origin_entity_name" binding:"required,min=3,max=60"`
+	DestinationWarehouseCode string            `json:"destination_warehouse_code" binding:"required,warehouse_code"`
+	Items                    []TransactionItem `json:"items" binding:"required,min=1,dive"`
+}
+
+type CreateOutboundTransaction struct {
+	OriginWarehouseCode string `json:"origin_warehouse_code" binding:"required,warehouse_code"`
+	// DestinationEntityName adalah nama customer / penerima barang
+	DestinationEntityName string            `json:"destination_entity_name" binding:"required,min=3,max=60"`
+	Items                 []TransactionItem `json:"items" binding:"required,min=1,dive"`
+}
+
+type CreateTransferTransaction struct {
+	OriginWarehouseCode      string            `json:"origin_warehouse_code" binding:"required,warehouse_code"`
+	DestinationWarehouseCode string            `json:"destination_warehouse_code" binding:"required,warehouse_code,nefield=OriginWarehouseCode"`
+	Items                    []TransactionItem `json:"items" binding:"required,min=1,dive"`
+}
+
+// ReceiveTransfer berisi jumlah barang yang diterima; boleh sebagian dari quantity dokumen
+type ReceiveTransfer struct {
+	Items []TransactionItem `json:"items" binding:"required,min=1,dive"`
+}
+
+// ScanItem adalah hasil scan barcode; quantity kosong dianggap 1
+type ScanItem struct {
+	Barcode  string `json:"barcode" binding:"required,barcode"`
+	Quantity int    `json:"quantity" binding:"omitempty,positive"`
+}
+
+type ChangeTransactionStatus struct {
+	IDStatus uint `json:"id_status" binding:"required"`
+}
@@ -1,12 +1,11 @@
 package request
 
 type CreateWarehouse struct {
-	WarehouseName       string `json:"warehouse_name" binding:"required, min=3, max=40"`
-	LocationDescription string `json:"location_description" binding:"required, min=23, max=60"`
+	WarehouseName       string `json:"warehouse_name" binding:"required,min=3,max=40"`
+	LocationDescription string `json:"location_description" binding:"required,min=23,max=60"`
 }
 
 type UpdateWarehouse struct {
-	WarehouseName       *string `json:"warehouse_name"`
-	WarehouseCode       string  `json:"warehouse_code" binding:"required"`
-	LocationDescription *string `json:"location_description"`
+	WarehouseName       *string `json:"warehouse_name" binding:"omitempty,min=3,max=40"`
+	LocationDescription *string `json:"location_description" binding:"omitempty,max=60"`
 }
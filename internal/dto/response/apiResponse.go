@@ -1,7 +1,10 @@
 package response
 
-type ApiResponse struct {
-	Status  int    `json:"status"`
-	Message string `json:"message"`
-	Data    any    `json:"data"`
-}
+import "github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/response"
+
+// ApiResponse dan Meta didefinisikan di pkg/response supaya middleware di pkg/
+// tidak bergantung pada package internal
+type (
+	ApiResponse = response.ApiResponse
+	Meta        = response.Meta
+)
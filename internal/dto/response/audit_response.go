@@ -0,0 +1,19 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditResponse struct {
+	ID                uint64          `json:"id"`
+	ActorEmployeeCode string          `json:"actor_employee_code"`
+	EntityType        string          `json:"entity_type"`
+	EntityKey         string          `json:"entity_key"`
+	Action            string          `json:"action"`
+	Before            json.RawMessage `json:"before" swaggertype:"object"`
+	After             json.RawMessage `json:"after" swaggertype:"object"`
+	Diff              json.RawMessage `json:"diff" swaggertype:"object"`
+	RequestID         string          `json:"request_id"`
+	CreatedAt         time.Time       `json:"created_at"`
+}
@@ -0,0 +1,14 @@
+package response
+
+import "time"
+
+type TokenResponse struct {
+	AccessToken      string    `json:"access_token"`
+	TokenType        string    `json:"token_type"`
+	ExpiresIn        int       `json:"expires_in"`
+	RefreshToken     string    `json:"refresh_token"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+	EmployeeCode     string    `json:"employee_code"`
+	Role             string    `json:"role"`
+	WarehouseCode    string    `json:"warehouse_code"`
+}
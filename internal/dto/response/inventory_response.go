@@ -0,0 +1,12 @@
+package response
+
+type InventoryResponse struct {
+	ID            int    `json:"id"`
+	CodeProduct   string `json:"code_product"`
+	ProductName   string `json:"product_name"`
+	IDSize        int    `json:"id_size"`
+	SizeName      string `json:"size_name"`
+	CodeWarehouse string `json:"code_warehouse"`
+	WarehouseName string `json:"warehouse_name"`
+	Quantity      int    `json:"quantity"`
+}
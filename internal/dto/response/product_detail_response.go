@@ -0,0 +1,17 @@
+package response
+
+type ProductDetailResponse struct {
+	ID          int    `json:"id"`
+	CodeProduct string `json:"code_product"`
+	IDSize      int    `json:"id_size"`
+	SizeName    string `json:"size_name"`
+	Barcode     string `json:"barcode"`
+}
+
+type BarcodeLookupResponse struct {
+	IDDetailProduct int               `json:"id_detail_product"`
+	Barcode         string            `json:"barcode"`
+	Product         ProductResponse   `json:"product"`
+	Size            SizeResponse      `json:"size"`
+	Category        CategoryResponses `json:"category"`
+}
@@ -0,0 +1,11 @@
+package response
+
+type ProductResponse struct {
+	ID                 int    `json:"id"`
+	ProductCode        string `json:"product_code"`
+	ProductName        string `json:"product_name"`
+	Price              int    `json:"price"`
+	DescriptionProduct string `json:"description_product"`
+	IDCategory         int    `json:"id_category"`
+	CategoryName       string `json:"category_name"`
+}
@@ -0,0 +1,7 @@
+package response
+
+type RoleResponse struct {
+	ID          int      `json:"id"`
+	RoleName    string   `json:"role_name"`
+	Permissions []string `json:"permissions"`
+}
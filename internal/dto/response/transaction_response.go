@@ -0,0 +1,39 @@
+package response
+
+import "time"
+
+type TransactionItemResponse struct {
+	ID               int    `json:"id"`
+	IDDetailProduct  int    `json:"id_detail_product"`
+	CodeProduct      string `json:"code_product"`
+	ProductName      string `json:"product_name"`
+	IDSize           int    `json:"id_size"`
+	SizeName         string `json:"size_name"`
+	Barcode          string `json:"barcode"`
+	Quantity         int    `json:"quantity"`
+	ScannerQuantity  int    `json:"scanner_quantity"`
+	ReceivedQuantity int    `json:"received_quantity"`
+}
+
+type TransactionResponse struct {
+	ID                    int                        `json:"id"`
+	CodeTransaksi         string                     `json:"code_transaksi"`
+	TipeTransaksi         string                     `json:"tipe_transaksi"`
+	OriginEntityName      string                     `json:"origin_entity_name"`
+	DestinationEntityName string                     `json:"destination_entity_name"`
+	EmployeeCode          string                     `json:"employee_code"`
+	IDStatus              int                        `json:"id_status"`
+	StatusName            string                     `json:"status_name"`
+	CreatedAt             time.Time                  `json:"created_at"`
+	Items                 []*TransactionItemResponse `json:"items"`
+}
+
+// ScanProgressResponse menampilkan progres scan seluruh dokumen setelah satu barcode di-scan
+type ScanProgressResponse struct {
+	CodeTransaksi string                     `json:"code_transaksi"`
+	Scanned       *TransactionItemResponse   `json:"scanned"`
+	TotalExpected int                        `json:"total_expected"`
+	TotalScanned  int                        `json:"total_scanned"`
+	Complete      bool                       `json:"complete"`
+	Items         []*TransactionItemResponse `json:"items"`
+}
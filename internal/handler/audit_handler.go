@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type AuditHandlerImpl struct {
+	srv service.AuditServices
+}
+
+func NewAuditHandler(srv service.AuditServices) AuditHandler {
+	return &AuditHandlerImpl{srv: srv}
+}
+
+// HandlerGetAllAudit godoc
+// @Summary      Audit Trail
+// @Description  Mengambil catatan create/update/delete beserta snapshot sebelum dan sesudah perubahan. Default data terbaru dulu
+// @Tags         audit
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit        query     int     false  "Jumlah data per halaman (default 20, maksimal 100)"
+// @Param        page         query     int     false  "Nomor halaman untuk pagination offset"
+// @Param        cursor       query     string  false  "next_cursor dari response sebelumnya"
+// @Param        sort         query     string  false  "id atau created_at; awali dengan - untuk urutan turun (default -id)"
+// @Param        actor        query     string  false  "Filter employee_code yang melakukan perubahan"
+// @Param        entity_type  query     string  false  "Filter jenis data: employee, warehouse, category, size, product, inventory"
+// @Param        entity_key   query     string  false  "Filter kunci data, misalnya employee_code atau id category"
+// @Param        action       query     string  false  "Filter aksi: create, update, delete"
+// @Param        request_id   query     string  false  "Filter X-Request-ID request yang melakukan perubahan"
+// @Param        since        query     string  false  "Mulai waktu (RFC3339 atau YYYY-MM-DD), inklusif"
+// @Param        until        query     string  false  "Sampai waktu (RFC3339 atau YYYY-MM-DD), eksklusif"
+// @Success      200          {array}   response.AuditResponse
+// @Failure      400          {object}  response.ApiResponse
+// @Failure      403          {object}  response.ApiResponse
+// @Failure      500          {object}  response.ApiResponse
+// @Router       /audit [get]
+func (a *AuditHandlerImpl) HandlerGetAllAudit(c *gin.Context) {
+	params, ok := listParams(c)
+	if !ok {
+		return
+	}
+
+	entries, meta, err := a.srv.GetAllAudit(c.Request.Context(), params)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    entries,
+		Meta:    meta,
+	})
+}
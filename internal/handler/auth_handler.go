@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type AuthHandlerImpl struct {
+	srv service.AuthServices
+}
+
+func NewAuthHandler(srv service.AuthServices) AuthHandler {
+	return &AuthHandlerImpl{srv: srv}
+}
+
+// HandlerLogin godoc
+// @Summary      Login
+// @Description  Login dengan user_id atau employee_code dan password. Mengembalikan access token (JWT) dan refresh token
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        login  body      request.Login  true  "Data Login"
+// @Success      200    {object}  response.TokenResponse
+// @Failure      400    {object}  response.ApiResponse
+// @Failure      401    {object}  response.ApiResponse  "user_id/employee_code atau password salah"
+// @Failure      422    {object}  response.ApiResponse  "Validasi gagal, data berisi daftar field yang tidak valid"
+// @Failure      500    {object}  response.ApiResponse
+// @Router       /auth/login [post]
+func (a *AuthHandlerImpl) HandlerLogin(c *gin.Context) {
+	var login request.Login
+
+	if !bindJSON(c, &login) {
+		return
+	}
+
+	token, err := a.srv.Login(c.Request.Context(), &login)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    token,
+	})
+}
+
+// HandlerRefresh godoc
+// @Summary      Refresh Token
+// @Description  Menukar refresh token dengan pasangan token baru. Refresh token lama langsung tidak berlaku (rotasi)
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        refresh  body      request.RefreshToken  true  "Refresh Token"
+// @Success      200      {object}  response.TokenResponse
+// @Failure      400      {object}  response.ApiResponse
+// @Failure      401      {object}  response.ApiResponse  "Refresh token tidak valid, kedaluwarsa atau sudah dicabut"
+// @Failure      422      {object}  response.ApiResponse  "Validasi gagal, data berisi daftar field yang tidak valid"
+// @Failure      500      {object}  response.ApiResponse
+// @Router       /auth/refresh [post]
+func (a *AuthHandlerImpl) HandlerRefresh(c *gin.Context) {
+	var refresh request.RefreshToken
+
+	if !bindJSON(c, &refresh) {
+		return
+	}
+
+	token, err := a.srv.Refresh(c.Request.Context(), &refresh)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    token,
+	})
+}
+
+// HandlerLogout godoc
+// @Summary      Logout
+// @Description  Mencabut access token yang sedang dipakai dan (opsional) seluruh sesi refresh token-nya
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        logout  body      request.Logout  false  "Refresh token yang ikut dicabut"
+// @Success      200     {object}  response.ApiResponse
+// @Failure      401     {object}  response.ApiResponse
+// @Failure      422     {object}  response.ApiResponse  "Validasi gagal, data berisi daftar field yang tidak valid"
+// @Failure      500     {object}  response.ApiResponse
+// @Router       /auth/logout [post]
+func (a *AuthHandlerImpl) HandlerLogout(c *gin.Context) {
+	claims, ok := auth.ClaimsFrom(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, response.ApiResponse{
+			Status:  http.StatusUnauthorized,
+			Message: auth.ErrInvalidToken.Error(),
+			Data:    nil,
+		})
+		return
+	}
+
+	// body boleh kosong
+	var logout request.Logout
+	if c.Request.ContentLength > 0 {
+		if !bindJSON(c, &logout) {
+			return
+		}
+	}
+
+	if err := a.srv.Logout(c.Request.Context(), claims, &logout); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    nil,
+	})
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/validation"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// bindJSON membaca body JSON ke obj dan menjalankan aturan di tag binding.
+// Body yang bukan JSON valid dijawab 400; aturan yang dilanggar dicatat untuk
+// middleware.ErrorHandler sebagai 422 berisi daftar field, dengan bahasa sesuai
+// header Accept-Language. ok bernilai false jika response sudah ditentukan.
+func bindJSON(c *gin.Context, obj any) bool {
+	err := c.ShouldBindJSON(obj)
+	if err == nil {
+		return true
+	}
+
+	var verrs validator.ValidationErrors
+	if errors.As(err, &verrs) {
+		writeError(c, validation.Translate(verrs, validation.Language(c.GetHeader("Accept-Language"))))
+		return false
+	}
+
+	writeError(c, apperror.BadRequest("invalid_json", "format JSON tidak valid"))
+	return false
+}
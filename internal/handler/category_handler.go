@@ -1,8 +1,6 @@
 package handler
 
 import (
-	"context"
-	"errors"
 	"net/http"
 	"strconv"
 
@@ -27,52 +25,24 @@ func NewCategoryHandler(srv service.CategoryServices) CategoryHandler {
 // @Accept       json
 // @Produce      json
 // @Param        Category  body      request.CreateCategory  true  "Data Category Baru"
-// @Success      201       {object}  models.Category
+// @Success      202       {object}  response.ApiResponse
 // @Failure      400       {object}  response.ApiResponse
 // @Failure      408       {object}  response.ApiResponse
+// @Failure      422       {object}  response.ApiResponse  "Validasi gagal, data berisi daftar field yang tidak valid"
 // @Failure      500       {object}  response.ApiResponse
 // @Failure      504       {object}  response.ApiResponse
 // @Router       /category [post]
 func (cg *CategoryHandlerImpl) HandlerCreateCategory(c *gin.Context) {
 	var category request.CreateCategory
 
-	err := c.ShouldBindJSON(&category)
-
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "format JSON tidak valid atau id warehouse tidak valid",
-			Data:    nil,
-		})
+	if !bindJSON(c, &category) {
 		return
 	}
 
-	err = cg.srv.CreateCategory(c.Request.Context(), &category)
+	err := cg.srv.CreateCategory(c.Request.Context(), &category)
 
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		if errors.Is(err, context.Canceled) {
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(500, response.ApiResponse{
-			Status:  500,
-			Message: "error when create warehouse",
-			Data:    nil,
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -90,31 +60,7 @@ func (cg *CategoryHandlerImpl) HandlerDeleteCategory(c *gin.Context) {
 	err := cg.srv.DeleteCategory(c.Request.Context(), val)
 
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		// Cek B: Apakah error karena Client Cancel (Tutup koneksi)?
-		if errors.Is(err, context.Canceled) {
-			// 499 (Client Closed Request)
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(http.StatusInternalServerError, response.ApiResponse{ // 500
-			Status:  http.StatusInternalServerError,
-			Message: "Terjadi kesalahan pada server",
-			Data:    nil, // Jangan tampilkan err asli ke user jika 500
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -125,25 +71,29 @@ func (cg *CategoryHandlerImpl) HandlerDeleteCategory(c *gin.Context) {
 	})
 }
 
+// HandlerGetAllCategory godoc
+// @Summary      Get Semua Category
+// @Description  Mengambil daftar category dengan pagination, sort dan filter
+// @Tags         Categories
+// @Produce      json
+// @Param        limit   query     int     false  "Jumlah data per halaman (default 20, maksimal 100)"
+// @Param        page    query     int     false  "Nomor halaman untuk pagination offset"
+// @Param        cursor  query     string  false  "next_cursor dari response sebelumnya"
+// @Param        sort    query     string  false  "id atau name; awali dengan - untuk urutan turun"
+// @Param        name    query     string  false  "Filter nama category (mengandung)"
+// @Success      200     {array}   response.CategoryResponses
+// @Failure      400     {object}  response.ApiResponse
+// @Failure      500     {object}  response.ApiResponse
+// @Router       /category [get]
 func (cg *CategoryHandlerImpl) HandlerGetAllCategory(c *gin.Context) {
-	resp, err := cg.srv.GetAllCategory(c.Request.Context())
+	params, ok := listParams(c)
+	if !ok {
+		return
+	}
 
+	resp, meta, err := cg.srv.GetAllCategory(c.Request.Context(), params)
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		// C. Internal Error (500)
-		c.JSON(http.StatusInternalServerError, response.ApiResponse{
-			Status:  http.StatusInternalServerError,
-			Message: "Terjadi kesalahan internal server",
-			Data:    nil,
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -151,6 +101,7 @@ func (cg *CategoryHandlerImpl) HandlerGetAllCategory(c *gin.Context) {
 		Status:  http.StatusOK,
 		Message: "success",
 		Data:    resp,
+		Meta:    meta,
 	})
 }
 
@@ -159,43 +110,14 @@ func (cg *CategoryHandlerImpl) HandlerUpdateCategory(c *gin.Context) {
 	val, _ := strconv.Atoi(id)
 
 	var category request.UpdatedCategory
-	err := c.ShouldBindJSON(&category)
-
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "format JSON tidak valid",
-			Data:    nil,
-		})
+	if !bindJSON(c, &category) {
 		return
 	}
 
-	err = cg.srv.UpdateCategory(c.Request.Context(), &category, val)
+	err := cg.srv.UpdateCategory(c.Request.Context(), &category, val)
 
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		if errors.Is(err, context.Canceled) {
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(http.StatusInternalServerError, response.ApiResponse{ // 500
-			Status:  http.StatusInternalServerError,
-			Message: "Terjadi kesalahan pada server",
-			Data:    nil, // Jangan tampilkan err asli ke user jika 500
-		})
+		writeError(c, err)
 		return
 	}
 
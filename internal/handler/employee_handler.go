@@ -1,15 +1,14 @@
 package handler
 
 import (
-	"context"
-	"errors"
 	"net/http"
 
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/validation"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
 	"github.com/gin-gonic/gin"
-	"github.com/gofrs/uuid"
 )
 
 type EmployeeHandlerImpl struct {
@@ -29,55 +28,25 @@ func NewEmployeeHandler(employeeService service.EmployeeServices) EmployeeHandle
 // @Accept       json
 // @Produce      json
 // @Param        employee  body      request.CreateEmployee  true  "Data Employee Baru"
-// @Success      201       {object}  models.Employee
+// @Success      201       {object}  response.ApiResponse
 // @Failure      400       {object}  response.ApiResponse
+// @Failure      403       {object}  response.ApiResponse  "Role memiliki permission yang tidak dimiliki user yang login"
 // @Failure      408       {object}  response.ApiResponse
+// @Failure      422       {object}  response.ApiResponse  "Validasi gagal, data berisi daftar field yang tidak valid"
 // @Failure      500       {object}  response.ApiResponse
 // @Failure      504       {object}  response.ApiResponse
 // @Router       /employees [post]
 func (e *EmployeeHandlerImpl) HandlerCreateEmployee(c *gin.Context) {
 	var employee request.CreateEmployee
 
-	err := c.ShouldBindJSON(&employee)
-
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "format JSON tidak valid atau id warehouse tidak valid",
-			Data:    nil,
-		})
+	if !bindJSON(c, &employee) {
 		return
 	}
 
-	err = e.EmployeeService.CreateEmployee(c.Request.Context(), &employee)
+	err := e.EmployeeService.CreateEmployee(c.Request.Context(), &employee)
 
 	if err != nil {
-		// Cek A: Apakah error karena Timeout?
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		// Cek B: Apakah error karena Client Cancel (Tutup koneksi)?
-		if errors.Is(err, context.Canceled) {
-			// 499 (Client Closed Request)
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(500, response.ApiResponse{
-			Status:  500,
-			Message: "error internal server",
-			Data:    nil,
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -90,60 +59,28 @@ func (e *EmployeeHandlerImpl) HandlerCreateEmployee(c *gin.Context) {
 
 // HandlerDeleteEmployee godoc
 // @Summary      Hapus Employee
-// @Description  Menghapus data employee berdasarkan ID
+// @Description  Menghapus data employee berdasarkan kode employee
 // @Tags         employees
 // @Produce      json
-// @Param        id   path      int  true  "Employee ID"
-// @Success      200  {object}  map[string]string
-// @Failure      400  {object}  response.ApiResponse  "ID tidak valid"
+// @Param        id   path      string  true  "Employee Code"
+// @Success      200  {object}  response.ApiResponse
+// @Failure      400  {object}  response.ApiResponse  "Kode employee tidak valid"
+// @Failure      403  {object}  response.ApiResponse  "Role employee memiliki permission yang tidak dimiliki user yang login"
 // @Failure      404  {object}  response.ApiResponse  "Employee tidak ditemukan"
 // @Failure      408  {object}  response.ApiResponse  "Request dibatalkan oleh client"
 // @Failure      504  {object}  response.ApiResponse
 // @Failure      500  {object}  response.ApiResponse
 // @Router       /employees/{id} [delete]
 func (e *EmployeeHandlerImpl) HandlerDeleteEmployee(c *gin.Context) {
-	id := c.Param("id")
-	_, err := uuid.FromString(id)
-
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "id tidak valid",
-			Data:    nil,
-		})
+	id, ok := employeeCodeParam(c)
+	if !ok {
 		return
 	}
 
-	err = e.EmployeeService.DeleteEmployee(c.Request.Context(), id)
+	err := e.EmployeeService.DeleteEmployee(c.Request.Context(), id)
 
 	if err != nil {
-
-		// Cek A: Apakah error karena Timeout?
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		// Cek B: Apakah error karena Client Cancel (Tutup koneksi)?
-		if errors.Is(err, context.Canceled) {
-			// 499 (Client Closed Request)
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(http.StatusInternalServerError, response.ApiResponse{ // 500
-			Status:  http.StatusInternalServerError,
-			Message: "Terjadi kesalahan pada server",
-			Data:    nil, // Jangan tampilkan err asli ke user jika 500
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -156,42 +93,38 @@ func (e *EmployeeHandlerImpl) HandlerDeleteEmployee(c *gin.Context) {
 
 // HandlerGetAllEmployee godoc
 // @Summary      Get Semua Employee
-// @Description  Mengambil daftar semua employee
+// @Description  Mengambil daftar employee dengan pagination, sort dan filter. Role tanpa akses semua warehouse hanya melihat employee di warehouse-nya
 // @Tags         employees
 // @Produce      json
-// @Success      200 {array}   models.Employee
+// @Param        limit   query     int     false  "Jumlah data per halaman (default 20, maksimal 100)"
+// @Param        page    query     int     false  "Nomor halaman untuk pagination offset"
+// @Param        cursor  query     string  false  "next_cursor dari response sebelumnya"
+// @Param        sort            query     string  false  "id, name atau code; awali dengan - untuk urutan turun"
+// @Param        name            query     string  false  "Filter nama employee (mengandung)"
+// @Param        warehouse_code  query     string  false  "Filter kode warehouse"
+// @Param        id_role         query     int     false  "Filter ID Role"
+// @Success      200 {array}   response.EmployeeResponse
+// @Failure      400 {object}  response.ApiResponse
 // @Failure      500 {object}  response.ApiResponse
 // @Failure      504 {object}  response.ApiResponse
 // @Router       /employees [get]
 func (e *EmployeeHandlerImpl) HandlerGetAllEmployee(c *gin.Context) {
-	employee, err := e.EmployeeService.GetAllEmployee(c.Request.Context())
+	params, ok := listParams(c)
+	if !ok {
+		return
+	}
 
+	employee, meta, err := e.EmployeeService.GetAllEmployee(c.Request.Context(), params)
 	if err != nil {
-
-		// A. Timeout / Cancel
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		// C. Internal Error (500)
-		c.JSON(http.StatusInternalServerError, response.ApiResponse{
-			Status:  http.StatusInternalServerError,
-			Message: "Terjadi kesalahan internal server",
-			Data:    nil,
-		})
+		writeError(c, err)
 		return
 	}
 
-	// 4. Sukses (200 OK)
 	c.JSON(http.StatusOK, response.ApiResponse{
 		Status:  http.StatusOK,
 		Message: "success",
-		Data:    employee, // Kirim object/struct data, bukan string
+		Data:    employee,
+		Meta:    meta,
 	})
 }
 
@@ -200,55 +133,27 @@ func (e *EmployeeHandlerImpl) HandlerGetAllEmployee(c *gin.Context) {
 // @Description  Mengambil daftar employee yang difilter berdasarkan warehouse_code
 // @Tags         employees
 // @Produce      json
-// @Param        warehouse_code   query     string  true  "Kode Warehouse"
-// @Success      200              {array}   models.Employee
-// @Failure      400              {object}  response.ApiResponse  "ID tidak valid"
+// @Param        id               path      string  true  "Kode Warehouse"
+// @Success      200              {array}   response.EmployeeResponse
+// @Failure      400              {object}  response.ApiResponse  "Kode warehouse tidak valid"
 // @Failure      500              {object}  response.ApiResponse
 // @Failure      504              {object}  response.ApiResponse
-// @Router       /employees/by-warehouse [get]
-// @Router       /employees [get]
+// @Router       /employees/by-warehouse/{id} [get]
 func (e *EmployeeHandlerImpl) HandlerGetAllEmployeeByWarehouse(c *gin.Context) {
-	id := c.Param("id")
-	_, err := uuid.FromString(id)
+	id, ok := warehouseCodeParam(c)
+	if !ok {
+		return
+	}
 
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "id tidak valid",
-			Data:    nil,
-		})
+	params, ok := listParams(c)
+	if !ok {
 		return
 	}
 
-	employee, err := e.EmployeeService.GetAllEmployeeByWarehouse(c.Request.Context(), id)
+	employee, meta, err := e.EmployeeService.GetAllEmployeeByWarehouse(c.Request.Context(), id, params)
 
 	if err != nil {
-		// Cek A: Apakah error karena Timeout?
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		// Cek B: Apakah error karena Client Cancel (Tutup koneksi)?
-		if errors.Is(err, context.Canceled) {
-			// 499 (Client Closed Request)
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(http.StatusInternalServerError, response.ApiResponse{ // 500
-			Status:  http.StatusInternalServerError,
-			Message: "Terjadi kesalahan pada server",
-			Data:    nil, // Jangan tampilkan err asli ke user jika 500
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -256,6 +161,7 @@ func (e *EmployeeHandlerImpl) HandlerGetAllEmployeeByWarehouse(c *gin.Context) {
 		Status:  200,
 		Message: "success",
 		Data:    employee,
+		Meta:    meta,
 	})
 }
 
@@ -265,41 +171,16 @@ func (e *EmployeeHandlerImpl) HandlerGetAllEmployeeByWarehouse(c *gin.Context) {
 // @Tags         employees
 // @Produce      json
 // @Param        id   path      int  true  "Employee ID"
-// @Success      200  {object}  models.Employee
+// @Success      200  {object}  response.EmployeeResponse
 // @Failure      400  {object}  response.ApiResponse  "ID tidak valid"
 // @Failure      404  {object}  response.ApiResponse  "Employee tidak ditemukan"
 // @Failure      500  {object}  response.ApiResponse
 // @Router       /employees/{id} [get]
 func (e *EmployeeHandlerImpl) HandlerGetEmployee(c *gin.Context) {
-	employee, err := e.EmployeeService.GetAllEmployee(c.Request.Context())
+	employee, err := e.EmployeeService.GetEmployeeById(c.Request.Context(), c.Param("id"))
 
 	if err != nil {
-		// Cek A: Apakah error karena Timeout?
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		// Cek B: Apakah error karena Client Cancel (Tutup koneksi)?
-		if errors.Is(err, context.Canceled) {
-			// 499 (Client Closed Request)
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(http.StatusInternalServerError, response.ApiResponse{ // 500
-			Status:  http.StatusInternalServerError,
-			Message: "Terjadi kesalahan pada server",
-			Data:    nil, // Jangan tampilkan err asli ke user jika 500
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -312,75 +193,37 @@ func (e *EmployeeHandlerImpl) HandlerGetEmployee(c *gin.Context) {
 
 // HandlerUpdateEmployee godoc
 // @Summary      Update Employee (Parsial)
-// @Description  Memperbarui data employee (bisa sebagian) berdasarkan ID
+// @Description  Memperbarui data employee (bisa sebagian) berdasarkan kode employee
 // @Tags         employees
 // @Accept       json
 // @Produce      json
-// @Param        id        path      int                        true  "Employee ID"
+// @Param        id        path      string                     true  "Employee Code"
 // @Param        employee  body      request.UpdatedEmployee  true  "Data update employee"
-// @Success      201       {object}  map[string]string
-// @Failure      400       {object}  response.ApiResponse  "ID atau data JSON tidak valid"
+// @Success      201       {object}  response.ApiResponse
+// @Failure      400       {object}  response.ApiResponse  "Kode employee atau data JSON tidak valid"
+// @Failure      403       {object}  response.ApiResponse  "Role memiliki permission yang tidak dimiliki user yang login"
 // @Failure      404       {object}  response.ApiResponse  "Employee tidak ditemukan"
 // @Failure      408       {object}  response.ApiResponse
+// @Failure      422       {object}  response.ApiResponse  "Validasi gagal, data berisi daftar field yang tidak valid"
 // @Failure      500       {object}  response.ApiResponse
 // @Failure      504       {object}  response.ApiResponse
 // @Router       /employees/{id} [patch]
 func (e *EmployeeHandlerImpl) HandlerUpdateEmployee(c *gin.Context) {
-	id := c.Param("id")
-	_, err := uuid.FromString(id)
-
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "id tidak valid",
-			Data:    nil,
-		})
+	id, ok := employeeCodeParam(c)
+	if !ok {
 		return
 	}
 
 	var employee request.UpdatedEmployee
 
-	err = c.ShouldBindJSON(&employee)
-
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "format JSON tidak valid atau id warehouse tidak valid",
-			Data:    "",
-		})
+	if !bindJSON(c, &employee) {
 		return
 	}
 
-	err = e.EmployeeService.UpdateEmployee(c.Request.Context(), id, &employee)
+	err := e.EmployeeService.UpdateEmployee(c.Request.Context(), id, &employee)
 
 	if err != nil {
-
-		// Cek A: Apakah error karena Timeout?
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		// Cek B: Apakah error karena Client Cancel (Tutup koneksi)?
-		if errors.Is(err, context.Canceled) {
-			// 499 (Client Closed Request)
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(500, response.ApiResponse{
-			Status:  500,
-			Message: "error internal server",
-			Data:    "",
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -390,3 +233,14 @@ func (e *EmployeeHandlerImpl) HandlerUpdateEmployee(c *gin.Context) {
 		Data:    nil,
 	})
 }
+
+// employeeCodeParam membaca kode employee dari path :id. ok bernilai false jika
+// response sudah ditentukan.
+func employeeCodeParam(c *gin.Context) (string, bool) {
+	code := c.Param("id")
+	if !validation.IsValidEmployeeCode(code) {
+		writeError(c, apperror.BadRequest("invalid_employee_code", "kode employee tidak valid"))
+		return "", false
+	}
+	return code, true
+}
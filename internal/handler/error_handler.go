@@ -0,0 +1,11 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// writeError mencatat error dari layer service ke context gin. Response-nya ditulis
+// oleh middleware.ErrorHandler sesuai jenis error (lihat package apperror).
+func writeError(c *gin.Context, err error) {
+	_ = c.Error(err)
+}
@@ -26,3 +26,65 @@ type CategoryHandler interface {
 	HandlerUpdateCategory(c *gin.Context)
 	HandlerDeleteCategory(c *gin.Context)
 }
+
+type SizeHandler interface {
+	HandlerGetAllSize(c *gin.Context)
+	HandlerCreateSize(c *gin.Context)
+	HandlerUpdateSize(c *gin.Context)
+	HandlerDeleteSize(c *gin.Context)
+}
+
+type ProductHandler interface {
+	HandlerGetAllProduct(c *gin.Context)
+	HandlerGetProduct(c *gin.Context)
+	HandlerGetProductByCode(c *gin.Context)
+	HandlerCreateProduct(c *gin.Context)
+	HandlerUpdateProduct(c *gin.Context)
+	HandlerDeleteProduct(c *gin.Context)
+}
+
+type ProductDetailHandler interface {
+	HandlerGetAllVariant(c *gin.Context)
+	HandlerCreateVariant(c *gin.Context)
+	HandlerDeleteVariant(c *gin.Context)
+	HandlerLookupBarcode(c *gin.Context)
+}
+
+type InventoryHandler interface {
+	HandlerGetStockByWarehouse(c *gin.Context)
+	HandlerGetStockByProduct(c *gin.Context)
+	HandlerGetStockByVariant(c *gin.Context)
+	HandlerAdjustStock(c *gin.Context)
+}
+
+type TransactionHandler interface {
+	HandlerGetTransaction(c *gin.Context)
+	HandlerCreateInbound(c *gin.Context)
+	HandlerCreateOutbound(c *gin.Context)
+	HandlerCreateTransfer(c *gin.Context)
+	HandlerDispatchTransfer(c *gin.Context)
+	HandlerReceiveTransfer(c *gin.Context)
+	HandlerCompleteTransaction(c *gin.Context)
+	HandlerChangeStatus(c *gin.Context)
+	HandlerScanItem(c *gin.Context)
+}
+
+type AuthHandler interface {
+	HandlerLogin(c *gin.Context)
+	HandlerRefresh(c *gin.Context)
+	HandlerLogout(c *gin.Context)
+}
+
+type RoleHandler interface {
+	HandlerGetAllRole(c *gin.Context)
+	HandlerGetRole(c *gin.Context)
+	HandlerCreateRole(c *gin.Context)
+	HandlerUpdateRole(c *gin.Context)
+	HandlerSetRolePermissions(c *gin.Context)
+	HandlerDeleteRole(c *gin.Context)
+	HandlerGetAllPermission(c *gin.Context)
+}
+
+type AuditHandler interface {
+	HandlerGetAllAudit(c *gin.Context)
+}
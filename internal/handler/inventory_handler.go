@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type InventoryHandlerImpl struct {
+	srv service.InventoryServices
+}
+
+func NewInventoryHandler(srv service.InventoryServices) InventoryHandler {
+	return &InventoryHandlerImpl{srv: srv}
+}
+
+// HandlerGetStockByWarehouse godoc
+// @Summary      Stok per Warehouse
+// @Description  Mengambil semua stok yang ada di satu warehouse
+// @Tags         inventory
+// @Produce      json
+// @Param        code  path      string  true  "Warehouse Code"
+// @Success      200   {array}   response.InventoryResponse
+// @Failure      500   {object}  response.ApiResponse
+// @Failure      504   {object}  response.ApiResponse
+// @Router       /inventory/warehouses/{code} [get]
+func (i *InventoryHandlerImpl) HandlerGetStockByWarehouse(c *gin.Context) {
+	stocks, err := i.srv.GetStockByWarehouse(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    stocks,
+	})
+}
+
+// HandlerGetStockByProduct godoc
+// @Summary      Stok per Product
+// @Description  Mengambil stok satu product di semua warehouse dan size
+// @Tags         inventory
+// @Produce      json
+// @Param        code  path      string  true  "Product Code"
+// @Success      200   {array}   response.InventoryResponse
+// @Failure      500   {object}  response.ApiResponse
+// @Failure      504   {object}  response.ApiResponse
+// @Router       /inventory/products/{code} [get]
+func (i *InventoryHandlerImpl) HandlerGetStockByProduct(c *gin.Context) {
+	stocks, err := i.srv.GetStockByProduct(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    stocks,
+	})
+}
+
+// HandlerGetStockByVariant godoc
+// @Summary      Stok per Variant
+// @Description  Mengambil stok satu variant (product + size) di semua warehouse
+// @Tags         inventory
+// @Produce      json
+// @Param        code     path      string  true  "Product Code"
+// @Param        id_size  path      int     true  "Size ID"
+// @Success      200      {array}   response.InventoryResponse
+// @Failure      400      {object}  response.ApiResponse
+// @Failure      500      {object}  response.ApiResponse
+// @Router       /inventory/products/{code}/sizes/{id_size} [get]
+func (i *InventoryHandlerImpl) HandlerGetStockByVariant(c *gin.Context) {
+	idSize, err := strconv.Atoi(c.Param("id_size"))
+	if err != nil {
+		c.JSON(400, response.ApiResponse{
+			Status:  400,
+			Message: "format id_size tidak valid",
+			Data:    nil,
+		})
+		return
+	}
+
+	stocks, err := i.srv.GetStockByVariant(c.Request.Context(), c.Param("code"), idSize)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    stocks,
+	})
+}
+
+// HandlerAdjustStock godoc
+// @Summary      Penyesuaian Stok
+// @Description  Menambah atau mengurangi stok satu variant di satu warehouse secara atomik
+// @Tags         inventory
+// @Accept       json
+// @Produce      json
+// @Param        adjustment  body      request.AdjustInventory  true  "Data penyesuaian stok"
+// @Success      200         {object}  response.InventoryResponse
+// @Failure      400         {object}  response.ApiResponse
+// @Failure      404         {object}  response.ApiResponse  "Variant tidak ditemukan"
+// @Failure      409         {object}  response.ApiResponse  "Stok tidak mencukupi"
+// @Failure      422         {object}  response.ApiResponse  "Warehouse tidak ditemukan"
+// @Failure      500         {object}  response.ApiResponse
+// @Router       /inventory/adjustments [post]
+func (i *InventoryHandlerImpl) HandlerAdjustStock(c *gin.Context) {
+	var adjustment request.AdjustInventory
+
+	if !bindJSON(c, &adjustment) {
+		return
+	}
+
+	stock, err := i.srv.AdjustStock(c.Request.Context(), &adjustment)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    stock,
+	})
+}
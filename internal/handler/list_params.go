@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/gin-gonic/gin"
+)
+
+// listParams membaca limit, page/cursor, sort dan filter dari query string.
+// Jika tidak valid, error sudah dicatat untuk middleware.ErrorHandler dan ok bernilai false.
+func listParams(c *gin.Context) (pagination.Params, bool) {
+	params, err := pagination.Parse(c.Request.URL.Query())
+	if err != nil {
+		writeError(c, err)
+		return pagination.Params{}, false
+	}
+	return params, true
+}
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ProductDetailHandlerImpl struct {
+	srv service.ProductDetailServices
+}
+
+func NewProductDetailHandler(srv service.ProductDetailServices) ProductDetailHandler {
+	return &ProductDetailHandlerImpl{srv: srv}
+}
+
+// HandlerGetAllVariant godoc
+// @Summary      Get Variant Product
+// @Description  Mengambil semua variant (size + barcode) dari satu product
+// @Tags         products
+// @Produce      json
+// @Param        id   path      int  true  "Product ID"
+// @Success      200  {array}   response.ProductDetailResponse
+// @Failure      400  {object}  response.ApiResponse  "ID tidak valid"
+// @Failure      404  {object}  response.ApiResponse  "Product tidak ditemukan"
+// @Failure      500  {object}  response.ApiResponse
+// @Router       /products/{id}/variants [get]
+func (p *ProductDetailHandlerImpl) HandlerGetAllVariant(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, response.ApiResponse{
+			Status:  400,
+			Message: "format id tidak valid",
+			Data:    nil,
+		})
+		return
+	}
+
+	variants, err := p.srv.GetAllVariant(c.Request.Context(), productID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    variants,
+	})
+}
+
+// HandlerCreateVariant godoc
+// @Summary      Tambah Variant Product
+// @Description  Menambahkan size variant ke product. Jika barcode kosong akan dibuat otomatis (EAN-13 atau Code128)
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                          true  "Product ID"
+// @Param        variant  body      request.CreateProductDetail  true  "Data Variant Baru"
+// @Success      201      {object}  response.ProductDetailResponse
+// @Failure      400      {object}  response.ApiResponse
+// @Failure      404      {object}  response.ApiResponse  "Product tidak ditemukan"
+// @Failure      409      {object}  response.ApiResponse  "Variant atau barcode sudah ada"
+// @Failure      422      {object}  response.ApiResponse  "Barcode atau size tidak valid"
+// @Failure      500      {object}  response.ApiResponse
+// @Router       /products/{id}/variants [post]
+func (p *ProductDetailHandlerImpl) HandlerCreateVariant(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, response.ApiResponse{
+			Status:  400,
+			Message: "format id tidak valid",
+			Data:    nil,
+		})
+		return
+	}
+
+	var variant request.CreateProductDetail
+	if !bindJSON(c, &variant) {
+		return
+	}
+
+	created, err := p.srv.CreateVariant(c.Request.Context(), productID, &variant)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.ApiResponse{
+		Status:  http.StatusCreated,
+		Message: "success",
+		Data:    created,
+	})
+}
+
+// HandlerDeleteVariant godoc
+// @Summary      Hapus Variant Product
+// @Description  Menghapus satu variant dari product
+// @Tags         products
+// @Produce      json
+// @Param        id          path      int  true  "Product ID"
+// @Param        variant_id  path      int  true  "Variant ID"
+// @Success      200         {object}  response.ApiResponse
+// @Failure      400         {object}  response.ApiResponse  "ID tidak valid"
+// @Failure      404         {object}  response.ApiResponse  "Variant tidak ditemukan"
+// @Failure      500         {object}  response.ApiResponse
+// @Router       /products/{id}/variants/{variant_id} [delete]
+func (p *ProductDetailHandlerImpl) HandlerDeleteVariant(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, response.ApiResponse{
+			Status:  400,
+			Message: "format id tidak valid",
+			Data:    nil,
+		})
+		return
+	}
+
+	variantID, err := strconv.Atoi(c.Param("variant_id"))
+	if err != nil {
+		c.JSON(400, response.ApiResponse{
+			Status:  400,
+			Message: "format variant_id tidak valid",
+			Data:    nil,
+		})
+		return
+	}
+
+	if err := p.srv.DeleteVariant(c.Request.Context(), productID, variantID); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    nil,
+	})
+}
+
+// HandlerLookupBarcode godoc
+// @Summary      Scan Barcode
+// @Description  Mencari product, size dan category dari barcode hasil scan
+// @Tags         barcodes
+// @Produce      json
+// @Param        barcode  path      string  true  "Barcode"
+// @Success      200      {object}  response.BarcodeLookupResponse
+// @Failure      404      {object}  response.ApiResponse  "Barcode tidak ditemukan"
+// @Failure      500      {object}  response.ApiResponse
+// @Router       /barcodes/{barcode} [get]
+func (p *ProductDetailHandlerImpl) HandlerLookupBarcode(c *gin.Context) {
+	result, err := p.srv.LookupBarcode(c.Request.Context(), c.Param("barcode"))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    result,
+	})
+}
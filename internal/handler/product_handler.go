@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ProductHandlerImpl struct {
+	srv service.ProductServices
+}
+
+func NewProductHandler(srv service.ProductServices) ProductHandler {
+	return &ProductHandlerImpl{srv: srv}
+}
+
+// HandlerGetAllProduct godoc
+// @Summary      Get Semua Product
+// @Description  Mengambil daftar product dengan pagination, sort dan filter
+// @Tags         products
+// @Produce      json
+// @Param        limit   query     int     false  "Jumlah data per halaman (default 20, maksimal 100)"
+// @Param        page    query     int     false  "Nomor halaman untuk pagination offset"
+// @Param        cursor  query     string  false  "next_cursor dari response sebelumnya"
+// @Param        sort         query     string  false  "id, name, code atau price; awali dengan - untuk urutan turun"
+// @Param        name         query     string  false  "Filter nama product (mengandung)"
+// @Param        code         query     string  false  "Filter kode product"
+// @Param        id_category  query     int     false  "Filter ID Category"
+// @Param        category     query     string  false  "Filter nama category (mengandung)"
+// @Success      200          {array}   response.ProductResponse
+// @Failure      400          {object}  response.ApiResponse
+// @Failure      500          {object}  response.ApiResponse
+// @Failure      504          {object}  response.ApiResponse
+// @Router       /products [get]
+func (p *ProductHandlerImpl) HandlerGetAllProduct(c *gin.Context) {
+	params, ok := listParams(c)
+	if !ok {
+		return
+	}
+
+	products, meta, err := p.srv.GetAllProduct(c.Request.Context(), params)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    products,
+		Meta:    meta,
+	})
+}
+
+// HandlerGetProduct godoc
+// @Summary      Get Product Berdasarkan ID
+// @Description  Mengambil satu data product berdasarkan ID
+// @Tags         products
+// @Produce      json
+// @Param        id   path      int  true  "Product ID"
+// @Success      200  {object}  response.ProductResponse
+// @Failure      400  {object}  response.ApiResponse  "ID tidak valid"
+// @Failure      404  {object}  response.ApiResponse  "Product tidak ditemukan"
+// @Failure      500  {object}  response.ApiResponse
+// @Router       /products/{id} [get]
+func (p *ProductHandlerImpl) HandlerGetProduct(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, response.ApiResponse{
+			Status:  400,
+			Message: "format id tidak valid",
+			Data:    nil,
+		})
+		return
+	}
+
+	product, err := p.srv.GetProductById(c.Request.Context(), id)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    product,
+	})
+}
+
+// HandlerGetProductByCode godoc
+// @Summary      Get Product Berdasarkan Product Code
+// @Description  Mengambil satu data product berdasarkan product_code
+// @Tags         products
+// @Produce      json
+// @Param        code  path      string  true  "Product Code"
+// @Success      200   {object}  response.ProductResponse
+// @Failure      404   {object}  response.ApiResponse  "Product tidak ditemukan"
+// @Failure      500   {object}  response.ApiResponse
+// @Router       /products/code/{code} [get]
+func (p *ProductHandlerImpl) HandlerGetProductByCode(c *gin.Context) {
+	product, err := p.srv.GetProductByCode(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    product,
+	})
+}
+
+// HandlerCreateProduct godoc
+// @Summary      Buat Product Baru
+// @Description  Membuat product baru, product_code dibuat otomatis
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        product  body      request.CreateProduct  true  "Data Product Baru"
+// @Success      201      {object}  response.ProductResponse
+// @Failure      400      {object}  response.ApiResponse
+// @Failure      422      {object}  response.ApiResponse  "Category tidak ditemukan"
+// @Failure      500      {object}  response.ApiResponse
+// @Router       /products [post]
+func (p *ProductHandlerImpl) HandlerCreateProduct(c *gin.Context) {
+	var product request.CreateProduct
+
+	if !bindJSON(c, &product) {
+		return
+	}
+
+	created, err := p.srv.CreateProduct(c.Request.Context(), &product)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.ApiResponse{
+		Status:  http.StatusCreated,
+		Message: "success",
+		Data:    created,
+	})
+}
+
+// HandlerUpdateProduct godoc
+// @Summary      Update Product (Parsial)
+// @Description  Memperbarui data product (bisa sebagian) berdasarkan ID
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                    true  "Product ID"
+// @Param        product  body      request.UpdateProduct  true  "Data update product"
+// @Success      200      {object}  response.ApiResponse
+// @Failure      400      {object}  response.ApiResponse  "ID atau data JSON tidak valid"
+// @Failure      404      {object}  response.ApiResponse  "Product tidak ditemukan"
+// @Failure      422      {object}  response.ApiResponse  "Category tidak ditemukan"
+// @Failure      500      {object}  response.ApiResponse
+// @Router       /products/{id} [patch]
+func (p *ProductHandlerImpl) HandlerUpdateProduct(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, response.ApiResponse{
+			Status:  400,
+			Message: "format id tidak valid",
+			Data:    nil,
+		})
+		return
+	}
+
+	var product request.UpdateProduct
+	if !bindJSON(c, &product) {
+		return
+	}
+
+	if err := p.srv.UpdateProduct(c.Request.Context(), id, &product); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    nil,
+	})
+}
+
+// HandlerDeleteProduct godoc
+// @Summary      Hapus Product
+// @Description  Menghapus product berdasarkan ID
+// @Tags         products
+// @Produce      json
+// @Param        id   path      int  true  "Product ID"
+// @Success      200  {object}  response.ApiResponse
+// @Failure      400  {object}  response.ApiResponse  "ID tidak valid"
+// @Failure      404  {object}  response.ApiResponse  "Product tidak ditemukan"
+// @Failure      500  {object}  response.ApiResponse
+// @Router       /products/{id} [delete]
+func (p *ProductHandlerImpl) HandlerDeleteProduct(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, response.ApiResponse{
+			Status:  400,
+			Message: "format id tidak valid",
+			Data:    nil,
+		})
+		return
+	}
+
+	if err := p.srv.DeleteProduct(c.Request.Context(), id); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    nil,
+	})
+}
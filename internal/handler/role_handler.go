@@ -0,0 +1,263 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type RoleHandlerImpl struct {
+	srv service.RoleServices
+}
+
+func NewRoleHandler(srv service.RoleServices) RoleHandler {
+	return &RoleHandlerImpl{srv: srv}
+}
+
+// HandlerGetAllRole godoc
+// @Summary      Daftar Role
+// @Description  Mengambil role beserta permission-nya dengan pagination, sort dan filter
+// @Tags         roles
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit   query     int     false  "Jumlah data per halaman (default 20, maksimal 100)"
+// @Param        page    query     int     false  "Nomor halaman untuk pagination offset"
+// @Param        cursor  query     string  false  "next_cursor dari response sebelumnya"
+// @Param        sort    query     string  false  "id atau name; awali dengan - untuk urutan turun"
+// @Param        name    query     string  false  "Filter nama role (mengandung)"
+// @Success      200     {array}   response.RoleResponse
+// @Failure      400     {object}  response.ApiResponse
+// @Failure      403     {object}  response.ApiResponse
+// @Failure      500     {object}  response.ApiResponse
+// @Router       /roles [get]
+func (r *RoleHandlerImpl) HandlerGetAllRole(c *gin.Context) {
+	params, ok := listParams(c)
+	if !ok {
+		return
+	}
+
+	roles, meta, err := r.srv.GetAllRole(c.Request.Context(), params)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    roles,
+		Meta:    meta,
+	})
+}
+
+// HandlerGetRole godoc
+// @Summary      Detail Role
+// @Description  Mengambil satu role beserta permission-nya
+// @Tags         roles
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "ID Role"
+// @Success      200  {object}  response.RoleResponse
+// @Failure      400  {object}  response.ApiResponse
+// @Failure      404  {object}  response.ApiResponse  "Role tidak ditemukan"
+// @Failure      500  {object}  response.ApiResponse
+// @Router       /roles/{id} [get]
+func (r *RoleHandlerImpl) HandlerGetRole(c *gin.Context) {
+	id, ok := roleIDParam(c)
+	if !ok {
+		return
+	}
+
+	role, err := r.srv.GetRoleById(c.Request.Context(), id)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    role,
+	})
+}
+
+// HandlerCreateRole godoc
+// @Summary      Buat Role Baru
+// @Description  Membuat role baru dengan daftar permission awal
+// @Tags         roles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        role  body      request.CreateRole  true  "Data Role Baru"
+// @Success      201   {object}  response.RoleResponse
+// @Failure      400   {object}  response.ApiResponse
+// @Failure      403   {object}  response.ApiResponse  "Permission tidak dimiliki user yang login"
+// @Failure      409   {object}  response.ApiResponse  "Nama role sudah dipakai"
+// @Failure      422   {object}  response.ApiResponse  "Permission tidak dikenal"
+// @Failure      500   {object}  response.ApiResponse
+// @Router       /roles [post]
+func (r *RoleHandlerImpl) HandlerCreateRole(c *gin.Context) {
+	var role request.CreateRole
+
+	if !bindJSON(c, &role) {
+		return
+	}
+
+	res, err := r.srv.CreateRole(c.Request.Context(), &role)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.ApiResponse{
+		Status:  http.StatusCreated,
+		Message: "success",
+		Data:    res,
+	})
+}
+
+// HandlerUpdateRole godoc
+// @Summary      Ubah Role
+// @Description  Mengganti nama role. Role super admin tidak bisa diganti namanya
+// @Tags         roles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      int                 true  "ID Role"
+// @Param        role  body      request.UpdateRole  true  "Data Role"
+// @Success      200   {object}  response.RoleResponse
+// @Failure      400   {object}  response.ApiResponse
+// @Failure      422   {object}  response.ApiResponse  "Validasi gagal, data berisi daftar field yang tidak valid"
+// @Failure      404   {object}  response.ApiResponse  "Role tidak ditemukan"
+// @Failure      409   {object}  response.ApiResponse  "Nama role sudah dipakai atau role dilindungi"
+// @Failure      500   {object}  response.ApiResponse
+// @Router       /roles/{id} [patch]
+func (r *RoleHandlerImpl) HandlerUpdateRole(c *gin.Context) {
+	id, ok := roleIDParam(c)
+	if !ok {
+		return
+	}
+
+	var role request.UpdateRole
+	if !bindJSON(c, &role) {
+		return
+	}
+
+	res, err := r.srv.UpdateRole(c.Request.Context(), id, &role)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    res,
+	})
+}
+
+// HandlerSetRolePermissions godoc
+// @Summary      Atur Permission Role
+// @Description  Mengganti seluruh permission role dengan daftar yang dikirim. List kosong mencabut semua permission
+// @Tags         roles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id           path      int                         true  "ID Role"
+// @Param        permissions  body      request.SetRolePermissions  true  "Daftar Permission"
+// @Success      200          {object}  response.RoleResponse
+// @Failure      400          {object}  response.ApiResponse
+// @Failure      403          {object}  response.ApiResponse  "Permission tidak dimiliki user yang login"
+// @Failure      404          {object}  response.ApiResponse  "Role tidak ditemukan"
+// @Failure      422          {object}  response.ApiResponse  "Permission tidak dikenal"
+// @Failure      500          {object}  response.ApiResponse
+// @Router       /roles/{id}/permissions [put]
+func (r *RoleHandlerImpl) HandlerSetRolePermissions(c *gin.Context) {
+	id, ok := roleIDParam(c)
+	if !ok {
+		return
+	}
+
+	var permissions request.SetRolePermissions
+	if !bindJSON(c, &permissions) {
+		return
+	}
+
+	res, err := r.srv.SetPermissions(c.Request.Context(), id, &permissions)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    res,
+	})
+}
+
+// HandlerDeleteRole godoc
+// @Summary      Hapus Role
+// @Description  Menghapus role. Role yang masih dipakai employee atau role super admin tidak bisa dihapus
+// @Tags         roles
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "ID Role"
+// @Success      200  {object}  response.ApiResponse
+// @Failure      400  {object}  response.ApiResponse
+// @Failure      404  {object}  response.ApiResponse  "Role tidak ditemukan"
+// @Failure      409  {object}  response.ApiResponse  "Role masih dipakai employee atau role dilindungi"
+// @Failure      500  {object}  response.ApiResponse
+// @Router       /roles/{id} [delete]
+func (r *RoleHandlerImpl) HandlerDeleteRole(c *gin.Context) {
+	id, ok := roleIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := r.srv.DeleteRole(c.Request.Context(), id); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    nil,
+	})
+}
+
+// HandlerGetAllPermission godoc
+// @Summary      Daftar Permission
+// @Description  Mengambil semua nama permission yang bisa diberikan ke role
+// @Tags         roles
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {array}   string
+// @Failure      403  {object}  response.ApiResponse
+// @Router       /permissions [get]
+func (r *RoleHandlerImpl) HandlerGetAllPermission(c *gin.Context) {
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    r.srv.GetAllPermission(c.Request.Context()),
+	})
+}
+
+// roleIDParam membaca parameter :id dan menulis 400 jika formatnya tidak valid
+func roleIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id < 1 {
+		c.JSON(400, response.ApiResponse{
+			Status:  400,
+			Message: "format id tidak valid",
+			Data:    nil,
+		})
+		return 0, false
+	}
+	return id, true
+}
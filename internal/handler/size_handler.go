@@ -1,8 +1,6 @@
 package handler
 
 import (
-	"context"
-	"errors"
 	"net/http"
 	"strconv"
 
@@ -24,44 +22,14 @@ func NewSizeHandlerImpl(srv service.SizeServices) SizeHandler {
 func (s *SizeHandlerImpl) HandlerCreateSize(c *gin.Context) {
 	var size request.CreateSize
 
-	err := c.ShouldBindJSON(&size)
-
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "format JSON tidak valid atau id warehouse tidak valid",
-			Data:    nil,
-		})
+	if !bindJSON(c, &size) {
 		return
 	}
 
-	err = s.srv.SaveSize(c.Request.Context(), &size)
+	err := s.srv.SaveSize(c.Request.Context(), &size)
 
 	if err != nil {
-
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		if errors.Is(err, context.Canceled) {
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(500, response.ApiResponse{
-			Status:  500,
-			Message: "error when create warehouse",
-			Data:    nil,
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -90,29 +58,7 @@ func (s *SizeHandlerImpl) HandlerDeleteSize(c *gin.Context) {
 	err = s.srv.DeleteSize(c.Request.Context(), val)
 
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		if errors.Is(err, context.Canceled) {
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(500, response.ApiResponse{
-			Status:  500,
-			Message: "error when create warehouse",
-			Data:    nil,
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -123,26 +69,29 @@ func (s *SizeHandlerImpl) HandlerDeleteSize(c *gin.Context) {
 	})
 }
 
-// HandlerGetAllSize implements SizeHandler.
+// HandlerGetAllSize godoc
+// @Summary      Get Semua Size
+// @Description  Mengambil daftar size dengan pagination, sort dan filter
+// @Tags         sizes
+// @Produce      json
+// @Param        limit   query     int     false  "Jumlah data per halaman (default 20, maksimal 100)"
+// @Param        page    query     int     false  "Nomor halaman untuk pagination offset"
+// @Param        cursor  query     string  false  "next_cursor dari response sebelumnya"
+// @Param        sort    query     string  false  "id atau name; awali dengan - untuk urutan turun"
+// @Param        name    query     string  false  "Filter nama size (mengandung)"
+// @Success      200     {array}   response.SizeResponse
+// @Failure      400     {object}  response.ApiResponse
+// @Failure      500     {object}  response.ApiResponse
+// @Router       /size [get]
 func (s *SizeHandlerImpl) HandlerGetAllSize(c *gin.Context) {
-	resp, err := s.srv.GetAllSize(c.Request.Context())
+	params, ok := listParams(c)
+	if !ok {
+		return
+	}
 
+	resp, meta, err := s.srv.GetAllSize(c.Request.Context(), params)
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		// C. Internal Error (500)
-		c.JSON(http.StatusInternalServerError, response.ApiResponse{
-			Status:  http.StatusInternalServerError,
-			Message: "Terjadi kesalahan internal server",
-			Data:    nil,
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -150,6 +99,7 @@ func (s *SizeHandlerImpl) HandlerGetAllSize(c *gin.Context) {
 		Status:  http.StatusOK,
 		Message: "success",
 		Data:    resp,
+		Meta:    meta,
 	})
 }
 
@@ -169,43 +119,14 @@ func (s *SizeHandlerImpl) HandlerUpdateSize(c *gin.Context) {
 		return
 	}
 
-	err = c.ShouldBindJSON(&req)
-
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "format JSON tidak valid",
-			Data:    nil,
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
 	err = s.srv.UpdateSize(c.Request.Context(), &req, conv)
 
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		if errors.Is(err, context.Canceled) {
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(http.StatusInternalServerError, response.ApiResponse{ // 500
-			Status:  http.StatusInternalServerError,
-			Message: "Terjadi kesalahan pada server",
-			Data:    nil,
-		})
+		writeError(c, err)
 		return
 	}
 
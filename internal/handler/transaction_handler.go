@@ -0,0 +1,299 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type TransactionHandlerImpl struct {
+	srv service.TransactionServices
+}
+
+func NewTransactionHandler(srv service.TransactionServices) TransactionHandler {
+	return &TransactionHandlerImpl{srv: srv}
+}
+
+// HandlerGetTransaction godoc
+// @Summary      Get Transaksi
+// @Description  Mengambil dokumen transaksi beserta seluruh baris detailnya
+// @Tags         transactions
+// @Produce      json
+// @Param        code  path      string  true  "Kode Transaksi"
+// @Success      200   {object}  response.TransactionResponse
+// @Failure      404   {object}  response.ApiResponse  "Transaksi tidak ditemukan"
+// @Failure      500   {object}  response.ApiResponse
+// @Router       /transactions/{code} [get]
+func (t *TransactionHandlerImpl) HandlerGetTransaction(c *gin.Context) {
+	trx, err := t.srv.GetTransaction(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    trx,
+	})
+}
+
+// HandlerCreateInbound godoc
+// @Summary      Buat Penerimaan Barang
+// @Description  Membuat dokumen penerimaan barang (inbound) dengan status Pending
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Param        transaction  body      request.CreateInboundTransaction  true  "Data Penerimaan Barang"
+// @Success      201          {object}  response.TransactionResponse
+// @Failure      400          {object}  response.ApiResponse
+// @Failure      401          {object}  response.ApiResponse  "Employee pencatat diambil dari access token"
+// @Failure      422          {object}  response.ApiResponse  "Warehouse atau variant tidak ditemukan"
+// @Failure      500          {object}  response.ApiResponse
+// @Router       /transactions/inbound [post]
+func (t *TransactionHandlerImpl) HandlerCreateInbound(c *gin.Context) {
+	var trx request.CreateInboundTransaction
+
+	if !bindJSON(c, &trx) {
+		return
+	}
+
+	created, err := t.srv.CreateInbound(c.Request.Context(), &trx)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.ApiResponse{
+		Status:  http.StatusCreated,
+		Message: "success",
+		Data:    created,
+	})
+}
+
+// HandlerCreateOutbound godoc
+// @Summary      Buat Pengiriman Barang
+// @Description  Membuat dokumen pengiriman (outbound) dengan status Pending dan memesan stok warehouse asal
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Param        transaction  body      request.CreateOutboundTransaction  true  "Data Pengiriman Barang"
+// @Success      201          {object}  response.TransactionResponse
+// @Failure      400          {object}  response.ApiResponse
+// @Failure      401          {object}  response.ApiResponse  "Employee pencatat diambil dari access token"
+// @Failure      409          {object}  response.ApiResponse  "Stok tidak mencukupi, data berisi kekurangan per baris"
+// @Failure      422          {object}  response.ApiResponse  "Warehouse atau variant tidak ditemukan"
+// @Failure      500          {object}  response.ApiResponse
+// @Router       /transactions/outbound [post]
+func (t *TransactionHandlerImpl) HandlerCreateOutbound(c *gin.Context) {
+	var trx request.CreateOutboundTransaction
+
+	if !bindJSON(c, &trx) {
+		return
+	}
+
+	created, err := t.srv.CreateOutbound(c.Request.Context(), &trx)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.ApiResponse{
+		Status:  http.StatusCreated,
+		Message: "success",
+		Data:    created,
+	})
+}
+
+// HandlerCreateTransfer godoc
+// @Summary      Buat Transfer Antar Warehouse
+// @Description  Membuat dokumen transfer dengan status Pending dan memesan stok warehouse asal
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Param        transaction  body      request.CreateTransferTransaction  true  "Data Transfer"
+// @Success      201          {object}  response.TransactionResponse
+// @Failure      400          {object}  response.ApiResponse
+// @Failure      401          {object}  response.ApiResponse  "Employee pencatat diambil dari access token"
+// @Failure      409          {object}  response.ApiResponse  "Stok tidak mencukupi, data berisi kekurangan per baris"
+// @Failure      422          {object}  response.ApiResponse  "Warehouse atau variant tidak ditemukan"
+// @Failure      500          {object}  response.ApiResponse
+// @Router       /transactions/transfer [post]
+func (t *TransactionHandlerImpl) HandlerCreateTransfer(c *gin.Context) {
+	var trx request.CreateTransferTransaction
+
+	if !bindJSON(c, &trx) {
+		return
+	}
+
+	created, err := t.srv.CreateTransfer(c.Request.Context(), &trx)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.ApiResponse{
+		Status:  http.StatusCreated,
+		Message: "success",
+		Data:    created,
+	})
+}
+
+// HandlerDispatchTransfer godoc
+// @Summary      Kirim Transfer
+// @Description  Mengurangi stok warehouse asal dan mengubah status transfer menjadi In Transit
+// @Tags         transactions
+// @Produce      json
+// @Param        code  path      string  true  "Kode Transaksi"
+// @Success      200   {object}  response.TransactionResponse
+// @Failure      404   {object}  response.ApiResponse  "Transaksi tidak ditemukan"
+// @Failure      409   {object}  response.ApiResponse  "Transaksi tidak bisa dikirim dari status saat ini atau stok tidak mencukupi"
+// @Failure      422   {object}  response.ApiResponse  "Transaksi bukan transfer"
+// @Failure      500   {object}  response.ApiResponse
+// @Router       /transactions/{code}/dispatch [post]
+func (t *TransactionHandlerImpl) HandlerDispatchTransfer(c *gin.Context) {
+	trx, err := t.srv.DispatchTransfer(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    trx,
+	})
+}
+
+// HandlerReceiveTransfer godoc
+// @Summary      Terima Transfer
+// @Description  Menambah stok warehouse tujuan sebanyak barang yang diterima (boleh sebagian). Transfer menjadi Completed jika semua barang sudah diterima
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Param        code     path      string                   true  "Kode Transaksi"
+// @Param        receive  body      request.ReceiveTransfer  true  "Barang yang diterima"
+// @Success      200      {object}  response.TransactionResponse
+// @Failure      400      {object}  response.ApiResponse
+// @Failure      404      {object}  response.ApiResponse  "Transaksi tidak ditemukan"
+// @Failure      409      {object}  response.ApiResponse  "Transaksi tidak In Transit"
+// @Failure      422      {object}  response.ApiResponse  "Variant tidak ada di dokumen atau jumlah melebihi sisa"
+// @Failure      500      {object}  response.ApiResponse
+// @Router       /transactions/{code}/receive [post]
+func (t *TransactionHandlerImpl) HandlerReceiveTransfer(c *gin.Context) {
+	var receive request.ReceiveTransfer
+
+	if !bindJSON(c, &receive) {
+		return
+	}
+
+	trx, err := t.srv.ReceiveTransfer(c.Request.Context(), c.Param("code"), &receive)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    trx,
+	})
+}
+
+// HandlerCompleteTransaction godoc
+// @Summary      Selesaikan Transaksi
+// @Description  Menandai transaksi Completed dan memperbarui stok dalam satu transaksi database
+// @Tags         transactions
+// @Produce      json
+// @Param        code  path      string  true  "Kode Transaksi"
+// @Success      200   {object}  response.TransactionResponse
+// @Failure      404   {object}  response.ApiResponse  "Transaksi tidak ditemukan"
+// @Failure      409   {object}  response.ApiResponse  "Status transaksi tidak bisa diubah menjadi Completed"
+// @Failure      500   {object}  response.ApiResponse
+// @Router       /transactions/{code}/complete [post]
+func (t *TransactionHandlerImpl) HandlerCompleteTransaction(c *gin.Context) {
+	trx, err := t.srv.CompleteTransaction(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    trx,
+	})
+}
+
+// HandlerScanItem godoc
+// @Summary      Scan Barcode Transaksi
+// @Description  Mencocokkan barcode ke baris dokumen, menambah scanner_quantity dan mengembalikan progres scan seluruh dokumen
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Param        code  path      string            true  "Kode Transaksi"
+// @Param        scan  body      request.ScanItem  true  "Barcode yang di-scan"
+// @Success      200   {object}  response.ScanProgressResponse
+// @Failure      400   {object}  response.ApiResponse
+// @Failure      404   {object}  response.ApiResponse  "Transaksi atau barcode tidak ditemukan"
+// @Failure      409   {object}  response.ApiResponse  "Transaksi sudah selesai atau baris sudah ter-scan penuh"
+// @Failure      422   {object}  response.ApiResponse  "Variant tidak ada di dokumen"
+// @Failure      500   {object}  response.ApiResponse
+// @Router       /transactions/{code}/scan [post]
+func (t *TransactionHandlerImpl) HandlerScanItem(c *gin.Context) {
+	var scan request.ScanItem
+
+	if !bindJSON(c, &scan) {
+		return
+	}
+
+	progress, err := t.srv.ScanItem(c.Request.Context(), c.Param("code"), &scan)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    progress,
+	})
+}
+
+// HandlerChangeStatus godoc
+// @Summary      Ubah Status Transaksi
+// @Description  Memindahkan status transaksi sesuai alur yang sah (Pending ke Completed/Failed, In Transit ke Failed jika barang hilang). Transfer dikirim lewat /dispatch dan diselesaikan lewat /receive. Completed dan Failed tidak bisa diubah lagi
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Param        code    path      string                           true  "Kode Transaksi"
+// @Param        status  body      request.ChangeTransactionStatus  true  "Status tujuan"
+// @Success      200     {object}  response.TransactionResponse
+// @Failure      400     {object}  response.ApiResponse
+// @Failure      404     {object}  response.ApiResponse  "Transaksi tidak ditemukan"
+// @Failure      409     {object}  response.ApiResponse  "Perpindahan status tidak sah, atau transfer harus lewat /dispatch dan /receive"
+// @Failure      422     {object}  response.ApiResponse  "Status tidak dikenal"
+// @Failure      500     {object}  response.ApiResponse
+// @Router       /transactions/{code}/status [patch]
+func (t *TransactionHandlerImpl) HandlerChangeStatus(c *gin.Context) {
+	var status request.ChangeTransactionStatus
+
+	if !bindJSON(c, &status) {
+		return
+	}
+
+	trx, err := t.srv.ChangeStatus(c.Request.Context(), c.Param("code"), &status)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ApiResponse{
+		Status:  http.StatusOK,
+		Message: "success",
+		Data:    trx,
+	})
+}
@@ -1,15 +1,14 @@
 package handler
 
 import (
-	"context"
-	"errors"
 	"net/http"
 
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/validation"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
 	"github.com/gin-gonic/gin"
-	"github.com/gofrs/uuid"
 )
 
 type WarehouseHandlerImpl struct {
@@ -27,53 +26,24 @@ func NewWarehouseHandler(warehouseService service.WarehouseServices) WarehouseHa
 // @Accept       json
 // @Produce      json
 // @Param        warehouse  body      request.CreateWarehouse true  "Data Warehouse Baru"
-// @Success      201        {object}  models.Warehouse
-// @Failure      400        {object}  request.ErrorResponse
-// @Failure      408        {object}  request.ErrorResponse
-// @Failure      500        {object}  request.ErrorResponse
-// @Failure      504        {object}  request.ErrorResponse
+// @Success      202        {object}  response.ApiResponse
+// @Failure      400        {object}  response.ApiResponse
+// @Failure      408        {object}  response.ApiResponse
+// @Failure      422        {object}  response.ApiResponse  "Validasi gagal, data berisi daftar field yang tidak valid"
+// @Failure      500        {object}  response.ApiResponse
+// @Failure      504        {object}  response.ApiResponse
 // @Router       /warehouses [post]
 func (w *WarehouseHandlerImpl) HandlerCreateWarehouse(c *gin.Context) {
 	var warehouse request.CreateWarehouse
 
-	err := c.ShouldBindJSON(&warehouse)
-
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "format JSON tidak valid atau id warehouse tidak valid",
-			Data:    nil,
-		})
+	if !bindJSON(c, &warehouse) {
 		return
 	}
 
-	err = w.WarehouseService.CreateWarehouse(c.Request.Context(), &warehouse)
+	err := w.WarehouseService.CreateWarehouse(c.Request.Context(), &warehouse)
 
 	if err != nil {
-
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		if errors.Is(err, context.Canceled) {
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(500, response.ApiResponse{
-			Status:  500,
-			Message: "error when create warehouse",
-			Data:    nil,
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -86,60 +56,27 @@ func (w *WarehouseHandlerImpl) HandlerCreateWarehouse(c *gin.Context) {
 
 // DeleteWarehouse godoc
 // @Summary      Hapus Warehouse
-// @Description  Menghapus data warehouse berdasarkan ID
+// @Description  Menghapus data warehouse berdasarkan kode warehouse
 // @Tags         warehouses
 // @Produce      json
-// @Param        id   path      int  true  "Warehouse ID"
-// @Success      200  {object}  map[string]string
-// @Failure      400  {object}  response.ApiResponse  "ID tidak valid"
+// @Param        id   path      string  true  "Warehouse Code"
+// @Success      200  {object}  response.ApiResponse
+// @Failure      400  {object}  response.ApiResponse  "Kode warehouse tidak valid"
 // @Failure      404  {object}  response.ApiResponse  "Warehoouse tidak ditemukan"
 // @Failure      408  {object}  response.ApiResponse  "Request dibatalkan oleh client"
 // @Failure      504  {object}  response.ApiResponse
 // @Failure      500  {object}  response.ApiResponse
 // @Router       /warehouses/{id} [delete]
 func (w *WarehouseHandlerImpl) HandlerDeleteWarehouse(c *gin.Context) {
-	id := c.Param("id")
-	_, err := uuid.FromString(id)
-
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "id tidak valid",
-			Data:    nil,
-		})
+	code, ok := warehouseCodeParam(c)
+	if !ok {
 		return
 	}
 
-	err = w.WarehouseService.DeleteWarehouse(c.Request.Context(), id)
+	err := w.WarehouseService.DeleteWarehouse(c.Request.Context(), code)
 
 	if err != nil {
-
-		// Cek A: Apakah error karena Timeout?
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		// Cek B: Apakah error karena Client Cancel (Tutup koneksi)?
-		if errors.Is(err, context.Canceled) {
-			// 499 (Client Closed Request)
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(http.StatusInternalServerError, response.ApiResponse{ // 500
-			Status:  http.StatusInternalServerError,
-			Message: "Terjadi kesalahan pada server",
-			Data:    nil, // Jangan tampilkan err asli ke user jika 500
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -152,114 +89,73 @@ func (w *WarehouseHandlerImpl) HandlerDeleteWarehouse(c *gin.Context) {
 
 // GetAllWarehouse godoc
 // @Summary      Get Semua Warehouse
-// @Description  Mengambil daftar semua warehouse
+// @Description  Mengambil daftar warehouse dengan pagination, sort dan filter
 // @Tags         warehouses
 // @Produce      json
-// @Success      200 {array}   models.Warehouse
+// @Param        limit   query     int     false  "Jumlah data per halaman (default 20, maksimal 100)"
+// @Param        page    query     int     false  "Nomor halaman untuk pagination offset"
+// @Param        cursor  query     string  false  "next_cursor dari response sebelumnya"
+// @Param        sort      query     string  false  "id, name atau code; awali dengan - untuk urutan turun"
+// @Param        name      query     string  false  "Filter nama warehouse (mengandung)"
+// @Param        code      query     string  false  "Filter kode warehouse"
+// @Param        location  query     string  false  "Filter lokasi (mengandung)"
+// @Success      200 {array}   response.WarehouseResponse
+// @Failure      400 {object}  response.ApiResponse
 // @Failure      500 {object}  response.ApiResponse
 // @Failure      504 {object}  response.ApiResponse
 // @Router       /warehouses [get]
 func (w *WarehouseHandlerImpl) HandlerGetAllWarehouse(c *gin.Context) {
-	warehouse, err := w.WarehouseService.GetAllWarehouse(c.Request.Context())
+	params, ok := listParams(c)
+	if !ok {
+		return
+	}
 
+	warehouse, meta, err := w.WarehouseService.GetAllWarehouse(c.Request.Context(), params)
 	if err != nil {
-
-		// A. Timeout / Cancel
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		// C. Internal Error (500)
-		c.JSON(http.StatusInternalServerError, response.ApiResponse{
-			Status:  http.StatusInternalServerError,
-			Message: "Terjadi kesalahan internal server",
-			Data:    nil,
-		})
+		writeError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, response.ApiResponse{
 		Status:  http.StatusOK,
 		Message: "success",
-		Data:    warehouse, // Kirim object/struct data, bukan string
+		Data:    warehouse,
+		Meta:    meta,
 	})
 }
 
 // UpdateWarehouse godoc
 // @Summary      Update Warehouse (Parsial)
-// @Description  Memperbarui data warehouse (bisa sebagian) berdasarkan ID
+// @Description  Memperbarui data warehouse (bisa sebagian) berdasarkan kode warehouse
 // @Tags         warehouses
 // @Accept       json
 // @Produce      json
-// @Param        id        path      int                      true  "Warehouse ID"
+// @Param        id        path      string                   true  "Warehouse Code"
 // @Param        warehouse body      request.UpdateWarehouse  true  "Data update warehouse"
-// @Success      201       {object}  map[string]string
-// @Failure      400       {object}  response.ApiResponse  "ID atau data JSON tidak valid"
+// @Success      201       {object}  response.ApiResponse
+// @Failure      400       {object}  response.ApiResponse  "Kode warehouse atau data JSON tidak valid"
 // @Failure      404       {object}  response.ApiResponse  "Employee tidak ditemukan"
 // @Failure      408       {object}  response.ApiResponse
+// @Failure      422       {object}  response.ApiResponse  "Validasi gagal, data berisi daftar field yang tidak valid"
 // @Failure      500       {object}  response.ApiResponse
 // @Failure      504       {object}  response.ApiResponse
 // @Router       /warehouses/{id} [patch]
 func (w *WarehouseHandlerImpl) HandlerUpdateWarehouse(c *gin.Context) {
-	id := c.Param("id")
-	_, err := uuid.FromString(id)
-
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "id tidak valid",
-			Data:    nil,
-		})
+	code, ok := warehouseCodeParam(c)
+	if !ok {
 		return
 	}
 
 	var warehouse request.UpdateWarehouse
 
-	err = c.ShouldBindJSON(&warehouse)
-
-	if err != nil {
-		c.JSON(400, response.ApiResponse{
-			Status:  400,
-			Message: "format JSON tidak valid atau id warehouse tidak valid",
-			Data:    "",
-		})
+	if !bindJSON(c, &warehouse) {
 		return
 	}
 
-	err = w.WarehouseService.UpdateWarehouse(c.Request.Context(), &warehouse)
+	err := w.WarehouseService.UpdateWarehouse(c.Request.Context(), code, &warehouse)
 
 	if err != nil {
-		// Cek A: Apakah error karena Timeout?
-		if errors.Is(err, context.DeadlineExceeded) {
-			c.JSON(http.StatusGatewayTimeout, response.ApiResponse{ // 504
-				Status:  http.StatusGatewayTimeout,
-				Message: "Request Timeout",
-				Data:    nil,
-			})
-			return
-		}
-
-		// Cek B: Apakah error karena Client Cancel (Tutup koneksi)?
-		if errors.Is(err, context.Canceled) {
-			// 499 (Client Closed Request)
-			c.JSON(408, response.ApiResponse{
-				Status:  408,
-				Message: "Request dibatalkan oleh client",
-				Data:    nil,
-			})
-			return
-		}
-
-		c.JSON(500, response.ApiResponse{
-			Status:  500,
-			Message: "error internal server",
-			Data:    "",
-		})
+		writeError(c, err)
 		return
 	}
 
@@ -269,3 +165,14 @@ func (w *WarehouseHandlerImpl) HandlerUpdateWarehouse(c *gin.Context) {
 		Data:    nil,
 	})
 }
+
+// warehouseCodeParam membaca kode warehouse dari path :id dengan aturan yang sama
+// seperti field warehouse_code. ok bernilai false jika response sudah ditentukan.
+func warehouseCodeParam(c *gin.Context) (string, bool) {
+	code := c.Param("id")
+	if !validation.IsValidWarehouseCode(code) {
+		writeError(c, apperror.BadRequest("invalid_warehouse_code", "kode warehouse tidak valid"))
+		return "", false
+	}
+	return code, true
+}
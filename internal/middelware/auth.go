@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	wmsmiddleware "github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// RevocationChecker mengecek apakah access token (jti) sudah dicabut lewat logout
+type RevocationChecker interface {
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// Authenticate memverifikasi header "Authorization: Bearer <token>" dan menyimpan
+// claims-nya ke context. Request tanpa token yang valid dihentikan dengan 401.
+func Authenticate(tokens *auth.TokenManager, revocations RevocationChecker) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		header := ctx.GetHeader("Authorization")
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+			abortUnauthorized(ctx, "Authorization header dengan Bearer token wajib diisi")
+			return
+		}
+
+		claims, err := tokens.ParseAccessToken(strings.TrimSpace(token))
+		if err != nil {
+			abortUnauthorized(ctx, err.Error())
+			return
+		}
+
+		revoked, err := revocations.IsTokenRevoked(ctx.Request.Context(), claims.ID)
+		if err != nil {
+			slog.ErrorContext(ctx.Request.Context(), "error on middleware Authenticate when check revoked token", "error", err)
+			wmsmiddleware.AbortInternalError(ctx)
+			return
+		}
+		if revoked {
+			abortUnauthorized(ctx, "token has been revoked")
+			return
+		}
+
+		auth.SetClaims(ctx, claims)
+		ctx.Next()
+	}
+}
+
+func abortUnauthorized(ctx *gin.Context, message string) {
+	ctx.Header("WWW-Authenticate", `Bearer realm="wms"`)
+	ctx.AbortWithStatusJSON(http.StatusUnauthorized, response.ApiResponse{
+		Status:  http.StatusUnauthorized,
+		Message: message,
+		Code:    "unauthorized",
+		Data:    nil,
+	})
+}
+
+// PermissionChecker mengecek permission yang dimiliki sebuah role
+type PermissionChecker interface {
+	HasPermission(ctx context.Context, claims *auth.Claims, permission auth.Permission) (bool, error)
+}
+
+// Authorize menolak request dengan 403 jika role employee yang login tidak memiliki permission.
+// Harus dipasang setelah Authenticate.
+func Authorize(checker PermissionChecker, permission auth.Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		claims, ok := auth.ClaimsFrom(ctx)
+		if !ok {
+			abortUnauthorized(ctx, auth.ErrInvalidToken.Error())
+			return
+		}
+
+		allowed, err := checker.HasPermission(ctx.Request.Context(), claims, permission)
+		if err != nil {
+			slog.ErrorContext(ctx.Request.Context(), "error on middleware Authorize when check permission", "error", err)
+			wmsmiddleware.AbortInternalError(ctx)
+			return
+		}
+
+		if !allowed {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, response.ApiResponse{
+				Status:  http.StatusForbidden,
+				Message: "role " + claims.Role + " tidak memiliki izin " + string(permission),
+				Code:    "permission_denied",
+				Data:    nil,
+			})
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// ScopeWarehouse menentukan warehouse yang boleh diakses request dan menyimpannya
+// ke context request, sehingga layer service bisa membatasi data per warehouse.
+// Harus dipasang setelah Authenticate.
+func ScopeWarehouse(checker PermissionChecker) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		claims, ok := auth.ClaimsFrom(ctx)
+		if !ok {
+			abortUnauthorized(ctx, auth.ErrInvalidToken.Error())
+			return
+		}
+
+		allWarehouses, err := checker.HasPermission(ctx.Request.Context(), claims, auth.PermWarehouseAll)
+		if err != nil {
+			slog.ErrorContext(ctx.Request.Context(), "error on middleware ScopeWarehouse when check permission", "error", err)
+			wmsmiddleware.AbortInternalError(ctx)
+			return
+		}
+
+		scope, ok := auth.ResolveScope(claims, ctx.GetHeader(auth.WarehouseHeader), allWarehouses)
+		if !ok {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, response.ApiResponse{
+				Status:  http.StatusForbidden,
+				Message: "role " + claims.Role + " hanya boleh mengakses warehouse " + claims.WarehouseCode,
+				Code:    "warehouse_forbidden",
+				Data:    nil,
+			})
+			return
+		}
+
+		ctx.Request = ctx.Request.WithContext(auth.WithScope(ctx.Request.Context(), scope))
+		ctx.Next()
+	}
+}
@@ -0,0 +1,352 @@
+// Package migrate menjalankan migration SQL bernomor (up, down, status, force) dan
+// mencatat versi yang sudah diterapkan di tabel schema_migrations.
+//
+// Setiap perintah memegang PostgreSQL advisory lock selama berjalan, sehingga beberapa
+// instance yang start bersamaan tidak menerapkan migration yang sama dua kali.
+// Setiap migration dijalankan di dalam transaksi bersama pencatatan versinya.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// advisoryLockKey adalah key pg_advisory_lock milik migration; nilainya bebas asalkan
+// tidak dipakai advisory lock lain di database yang sama
+const advisoryLockKey int64 = 0x574d535f4d4947 // "WMS_MIG"
+
+const createTableQuery = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version    BIGINT PRIMARY KEY,
+	name       TEXT NOT NULL,
+	applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Migration adalah satu versi migration beserta SQL up dan down-nya
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status adalah keadaan satu versi di database. Missing bernilai true jika versi
+// tercatat sudah diterapkan tetapi file migration-nya tidak ada di binary.
+type Status struct {
+	Version   uint64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+	Missing   bool
+}
+
+// Load membaca file <versi>_<nama>.up.sql dan .down.sql dari fsys, urut naik berdasarkan versi.
+// File lain di fsys diabaikan.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[uint64]*Migration{}
+	for _, entry := range entries {
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", entry.Name(), err)
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d is used by %s and %s", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New membuat Migrator untuk migration yang ada di fsys
+func New(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Up menerapkan maksimal n migration yang belum diterapkan, urut naik.
+// n <= 0 berarti semua migration yang tertunda.
+func (m *Migrator) Up(ctx context.Context, n int) ([]Migration, error) {
+	var done []Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			if n > 0 && len(done) == n {
+				break
+			}
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+
+			err := run(ctx, conn, mig.Up,
+				`INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, mig.Version, mig.Name)
+			if err != nil {
+				return fmt.Errorf("migration %d_%s up: %w", mig.Version, mig.Name, err)
+			}
+			slog.InfoContext(ctx, "migration applied", "version", mig.Version, "name", mig.Name)
+			done = append(done, mig)
+		}
+		return nil
+	})
+
+	return done, err
+}
+
+// Down membatalkan n migration terakhir yang sudah diterapkan, urut turun
+func (m *Migrator) Down(ctx context.Context, n int) ([]Migration, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("number of migrations to roll back must be at least 1")
+	}
+
+	var done []Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		versions := make([]uint64, 0, len(applied))
+		for version := range applied {
+			versions = append(versions, version)
+		}
+		sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+
+		for _, version := range versions {
+			if len(done) == n {
+				break
+			}
+
+			mig, ok := m.find(version)
+			if !ok {
+				return fmt.Errorf("migration %d is applied but its files are missing", version)
+			}
+			if mig.Down == "" {
+				return fmt.Errorf("migration %d_%s has no down file", mig.Version, mig.Name)
+			}
+
+			err := run(ctx, conn, mig.Down,
+				`DELETE FROM schema_migrations WHERE version = $1`, mig.Version)
+			if err != nil {
+				return fmt.Errorf("migration %d_%s down: %w", mig.Version, mig.Name, err)
+			}
+			slog.InfoContext(ctx, "migration rolled back", "version", mig.Version, "name", mig.Name)
+			done = append(done, mig)
+		}
+		return nil
+	})
+
+	return done, err
+}
+
+// Status mengembalikan keadaan semua migration, termasuk versi tercatat yang filenya tidak ada
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		rows, err := conn.QueryContext(ctx, `SELECT version, name, applied_at FROM schema_migrations`)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		applied := map[uint64]Status{}
+		for rows.Next() {
+			var s Status
+			if err := rows.Scan(&s.Version, &s.Name, &s.AppliedAt); err != nil {
+				return err
+			}
+			s.Applied = true
+			applied[s.Version] = s
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			s, ok := applied[mig.Version]
+			if !ok {
+				s = Status{Version: mig.Version}
+			}
+			s.Name = mig.Name
+			statuses = append(statuses, s)
+			delete(applied, mig.Version)
+		}
+		for _, s := range applied {
+			s.Missing = true
+			statuses = append(statuses, s)
+		}
+		sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+
+		return nil
+	})
+
+	return statuses, err
+}
+
+// Force mencatat semua migration sampai version sebagai sudah diterapkan dan menghapus
+// catatan versi yang lebih baru, tanpa menjalankan SQL apa pun. Dipakai untuk database
+// yang skemanya dibuat manual atau setelah memperbaiki migration yang gagal di tengah jalan.
+// version 0 berarti belum ada migration yang diterapkan.
+func (m *Migrator) Force(ctx context.Context, version uint64) error {
+	if _, ok := m.find(version); version != 0 && !ok {
+		return fmt.Errorf("migration version %d does not exist", version)
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version > $1`, version); err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			if mig.Version > version {
+				break
+			}
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO schema_migrations (version, name) VALUES ($1, $2) ON CONFLICT (version) DO NOTHING`,
+				mig.Version, mig.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "migration version forced", "version", version)
+		return nil
+	})
+}
+
+func (m *Migrator) find(version uint64) (Migration, bool) {
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			return mig, true
+		}
+	}
+	return Migration{}, false
+}
+
+// withLock menjalankan fn di satu koneksi yang memegang advisory lock. Lock advisory
+// berlaku per session, jadi semua query harus memakai koneksi yang sama.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, advisoryLockKey); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		// tetap dilepas walaupun ctx sudah dibatalkan, karena koneksi kembali ke pool
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock($1)`, advisoryLockKey); err != nil {
+			slog.ErrorContext(ctx, "failed to release migration lock", "error", err)
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, createTableQuery); err != nil {
+		return fmt.Errorf("create schema_migrations table: %w", err)
+	}
+
+	return fn(conn)
+}
+
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[uint64]struct{}, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[uint64]struct{}{}
+	for rows.Next() {
+		var version uint64
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		applied[version] = struct{}{}
+	}
+	return applied, rows.Err()
+}
+
+// run menjalankan SQL migration dan query pencatatan versinya dalam satu transaksi,
+// sehingga migration yang gagal tidak meninggalkan skema setengah jadi
+func run(ctx context.Context, conn *sql.Conn, script, record string, args ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
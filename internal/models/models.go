@@ -1,9 +1,8 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
-
-	"github.com/gofrs/uuid"
 )
 
 // 1. Category
@@ -19,7 +18,10 @@ func (Category) TableName() string {
 // 2. Role
 type Role struct {
 	ID       uint   `gorm:"primaryKey" json:"id"`
-	RoleName string `gorm:"not null" json:"role_name"`
+	RoleName string `gorm:"not null;unique" json:"role_name"`
+
+	// Permission bernama dari tabel role_permission
+	Permissions []string `gorm:"-" json:"permissions"`
 }
 
 func (Role) TableName() string {
@@ -48,10 +50,10 @@ func (Status) TableName() string {
 
 // 5. Warehouse
 type Warehouse struct {
-	ID                  uint      `gorm:"primaryKey" json:"id"`
-	WarehouseName       string    `gorm:"not null;unique" json:"warehouse_name"`
-	WarehouseCode       uuid.UUID `gorm:"not null;unique" json:"warehouse_code"`
-	LocationDescription string    `json:"location_description"`
+	ID                  uint   `gorm:"primaryKey" json:"id"`
+	WarehouseName       string `gorm:"not null;unique" json:"warehouse_name"`
+	WarehouseCode       string `gorm:"not null;unique" json:"warehouse_code"`
+	LocationDescription string `json:"location_description"`
 
 	// Relasi (Has Many)
 	Inventories []Inventory `gorm:"foreignKey:CodeWarehouse;references:WarehouseCode" json:"inventories,omitempty"`
@@ -164,6 +166,8 @@ type DetailTransaction struct {
 	IDDetailProduct uint `gorm:"not null;uniqueIndex:idx_trx_product" json:"id_detail_product"`
 	Quantity        int  `gorm:"not null" json:"quantity"`
 	ScannerQuantity int  `gorm:"not null;default:0" json:"scanner_quantity"`
+	// ReceivedQuantity hanya dipakai transfer, jumlah yang sudah diterima warehouse tujuan
+	ReceivedQuantity int `gorm:"not null;default:0" json:"received_quantity"`
 
 	// Relasi (Belongs To)
 	ProductDetail ProductDetail `gorm:"foreignKey:IDDetailProduct" json:"product_detail"`
@@ -173,3 +177,38 @@ type DetailTransaction struct {
 func (DetailTransaction) TableName() string {
 	return "detail_transactions"
 }
+
+// 12. RefreshToken
+type RefreshToken struct {
+	ID           uint       `gorm:"primaryKey" json:"id"`
+	TokenHash    string     `gorm:"not null;unique" json:"-"`
+	EmployeeCode string     `gorm:"not null" json:"employee_code"`
+	FamilyID     string     `gorm:"not null;index" json:"family_id"`
+	ExpiresAt    time.Time  `gorm:"not null" json:"expires_at"`
+	RevokedAt    *time.Time `json:"revoked_at"`
+	ReplacedBy   *uint      `json:"replaced_by"`
+	CreatedAt    time.Time  `gorm:"not null" json:"created_at"`
+}
+
+func (RefreshToken) TableName() string {
+	return "refresh_token"
+}
+
+// 13. AuditLog
+type AuditLog struct {
+	ID                uint64 `gorm:"primaryKey" json:"id"`
+	ActorEmployeeCode string `json:"actor_employee_code"`
+	EntityType        string `gorm:"not null;index:idx_audit_entity" json:"entity_type"`
+	EntityKey         string `gorm:"not null;index:idx_audit_entity" json:"entity_key"`
+	Action            string `gorm:"not null" json:"action"`
+	// Before, After dan Diff berupa JSON; Before kosong untuk create dan After kosong untuk delete
+	Before    json.RawMessage `json:"before"`
+	After     json.RawMessage `json:"after"`
+	Diff      json.RawMessage `gorm:"not null" json:"diff"`
+	RequestID string          `json:"request_id"`
+	CreatedAt time.Time       `gorm:"not null" json:"created_at"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_log"
+}
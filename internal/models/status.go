@@ -0,0 +1,29 @@
+package models
+
+// ID status sesuai urutan data awal pada migration 000003_add_default_data
+// dan 000005_add_transfer_support
+const (
+	StatusFailed    uint = 1
+	StatusPending   uint = 2
+	StatusCompleted uint = 3
+	StatusInTransit uint = 4
+)
+
+// StatusNames adalah nama setiap status pada tabel status
+var StatusNames = map[uint]string{
+	StatusFailed:    "Failed",
+	StatusPending:   "Pending",
+	StatusCompleted: "Completed",
+	StatusInTransit: "In Transit",
+}
+
+// Tipe transaksi yang disimpan di kolom transactions.tipe_transaksi
+const (
+	TransactionInbound  = "INBOUND"
+	TransactionOutbound = "OUTBOUND"
+	TransactionTransfer = "TRANSFER"
+)
+
+// ReservingTransactionTypes adalah tipe transaksi yang memesan stok warehouse asal
+// selama dokumennya masih Pending
+var ReservingTransactionTypes = []string{TransactionOutbound, TransactionTransfer}
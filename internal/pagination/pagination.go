@@ -0,0 +1,149 @@
+// Package pagination berisi spesifikasi list (limit, page/cursor, sort dan filter)
+// yang dibaca dari query parameter dan dipakai oleh setiap FindAll di layer repository.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// query parameter yang dipakai untuk pagination; parameter lain dianggap filter
+const (
+	paramLimit  = "limit"
+	paramPage   = "page"
+	paramCursor = "cursor"
+	paramSort   = "sort"
+)
+
+var (
+	ErrInvalidLimit  = apperror.BadRequest("invalid_limit", "limit must be a number between 1 and 100")
+	ErrInvalidPage   = apperror.BadRequest("invalid_page", "page must be a number greater than 0")
+	ErrInvalidCursor = apperror.BadRequest("invalid_cursor", "cursor is invalid or does not match the requested sort")
+	ErrPageAndCursor = apperror.BadRequest("page_and_cursor", "page and cursor cannot be used together")
+	ErrInvalidSort   = apperror.BadRequest("invalid_sort", "sort field is not supported")
+	ErrInvalidFilter = apperror.BadRequest("invalid_filter", "filter is not supported or has an invalid value")
+)
+
+// Params adalah spesifikasi list yang diminta client.
+// Page bernilai 0 jika client memakai cursor (atau tidak mengirim keduanya).
+type Params struct {
+	Limit   int
+	Page    int
+	Cursor  *Cursor
+	Sort    string
+	Desc    bool
+	Filters map[string]string
+}
+
+// Cursor menunjuk baris terakhir halaman sebelumnya. Value adalah nilai kolom sort
+// dan Key adalah id baris tersebut, sehingga halaman berikutnya tetap stabil walaupun
+// ada data baru yang masuk.
+type Cursor struct {
+	Sort  string `json:"s"`
+	Desc  bool   `json:"d,omitempty"`
+	Value string `json:"v"`
+	Key   string `json:"k"`
+}
+
+// Page adalah metadata hasil list yang dikembalikan bersama data
+type Page struct {
+	Total      int64
+	Limit      int
+	Page       int
+	NextCursor string
+}
+
+// Parse membaca spesifikasi list dari query parameter:
+//
+//	limit=20            jumlah data per halaman (maksimal 100)
+//	page=2              pagination offset, dimulai dari 1
+//	cursor=<token>      pagination cursor dari next_cursor response sebelumnya
+//	sort=name / -name   urutkan naik / turun berdasarkan field
+//	<field>=<nilai>     filter, field yang didukung tergantung endpoint
+func Parse(values url.Values) (Params, error) {
+	p := Params{
+		Limit:   DefaultLimit,
+		Filters: map[string]string{},
+	}
+
+	if raw := values.Get(paramLimit); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > MaxLimit {
+			return Params{}, ErrInvalidLimit
+		}
+		p.Limit = limit
+	}
+
+	if raw := values.Get(paramPage); raw != "" {
+		page, err := strconv.Atoi(raw)
+		if err != nil || page < 1 {
+			return Params{}, ErrInvalidPage
+		}
+		p.Page = page
+	}
+
+	if raw := values.Get(paramSort); raw != "" {
+		p.Sort, p.Desc = strings.TrimPrefix(raw, "-"), strings.HasPrefix(raw, "-")
+	}
+
+	if raw := values.Get(paramCursor); raw != "" {
+		if p.Page != 0 {
+			return Params{}, ErrPageAndCursor
+		}
+		cursor, err := DecodeCursor(raw)
+		if err != nil {
+			return Params{}, err
+		}
+		p.Cursor = cursor
+	}
+
+	for key := range values {
+		switch key {
+		case paramLimit, paramPage, paramCursor, paramSort:
+			continue
+		}
+		if value := values.Get(key); value != "" {
+			p.Filters[key] = value
+		}
+	}
+
+	return p, nil
+}
+
+// Offset mengembalikan jumlah baris yang dilewati untuk pagination offset
+func (p Params) Offset() int {
+	if p.Page < 2 {
+		return 0
+	}
+	return (p.Page - 1) * p.Limit
+}
+
+// EncodeCursor mengubah cursor menjadi token yang aman dipakai di URL
+func EncodeCursor(c Cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor membaca token dari EncodeCursor
+func DecodeCursor(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	c := &Cursor{}
+	if err := json.Unmarshal(raw, c); err != nil || c.Key == "" {
+		return nil, ErrInvalidCursor
+	}
+	return c, nil
+}
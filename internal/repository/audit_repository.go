@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+)
+
+type AuditRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewAuditRepository(db *sql.DB) AuditRepository {
+	return &AuditRepositoryImpl{
+		db: db,
+	}
+}
+
+// auditList adalah sort dan filter yang didukung FindAll audit; default data terbaru dulu
+var auditList = listSpec{
+	columns: `id, COALESCE(actor_employee_code, ''), entity_type, entity_key, action,
+		before, after, diff, COALESCE(request_id, ''), created_at`,
+	from:        `audit_log`,
+	key:         `id`,
+	defaultSort: "id",
+	defaultDesc: true,
+	sorts: map[string]string{
+		"id":         `id`,
+		"created_at": `created_at`,
+	},
+	filters: map[string]listFilter{
+		"actor":       {column: `actor_employee_code`, kind: filterEquals},
+		"entity_type": {column: `entity_type`, kind: filterEquals},
+		"entity_key":  {column: `entity_key`, kind: filterEquals},
+		"action":      {column: `action`, kind: filterEquals},
+		"request_id":  {column: `request_id`, kind: filterEquals},
+		"since":       {column: `created_at`, kind: filterSince},
+		"until":       {column: `created_at`, kind: filterUntil},
+	},
+}
+
+// FindAll implements AuditRepository.
+func (r *AuditRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.AuditLog, pagination.Page, error) {
+	return findList(ctx, conn(ctx, r.db), "FindAll audit", auditList, p, func(rows *sql.Rows, extra ...any) (*models.AuditLog, error) {
+		entry := &models.AuditLog{}
+		// scan lewat []byte supaya database/sql menyalin isi buffer driver
+		var before, after, diff []byte
+		err := rows.Scan(append([]any{
+			&entry.ID,
+			&entry.ActorEmployeeCode,
+			&entry.EntityType,
+			&entry.EntityKey,
+			&entry.Action,
+			&before,
+			&after,
+			&diff,
+			&entry.RequestID,
+			&entry.CreatedAt,
+		}, extra...)...)
+		entry.Before, entry.After, entry.Diff = before, after, diff
+		return entry, err
+	})
+}
+
+// Save implements AuditRepository.
+func (r *AuditRepositoryImpl) Save(ctx context.Context, entry *models.AuditLog) error {
+	query := `
+		INSERT INTO audit_log
+			(actor_employee_code, entity_type, entity_key, action, before, after, diff, request_id)
+		VALUES
+			(NULLIF($1, ''), $2, $3, $4, $5, $6, $7, NULLIF($8, ''))
+		RETURNING
+			id, created_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		entry.ActorEmployeeCode,
+		entry.EntityType,
+		entry.EntityKey,
+		entry.Action,
+		nullJSON(entry.Before),
+		nullJSON(entry.After),
+		[]byte(entry.Diff),
+		entry.RequestID,
+	).Scan(&entry.ID, &entry.CreatedAt)
+
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method Save audit in repository layer", "error", err)
+		return dbError(err, nil)
+	}
+
+	return nil
+}
+
+// nullJSON menyimpan snapshot kosong sebagai NULL, bukan JSON yang tidak valid
+func nullJSON(raw []byte) any {
+	if len(raw) == 0 {
+		return nil
+	}
+	return raw
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+)
+
+type AuthRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewAuthRepository(db *sql.DB) AuthRepository {
+	return &AuthRepositoryImpl{
+		db: db,
+	}
+}
+
+// SaveRefreshToken implements AuthRepository.
+func (r *AuthRepositoryImpl) SaveRefreshToken(ctx context.Context, token *models.RefreshToken) error {
+	if err := insertRefreshToken(ctx, conn(ctx, r.db), token); err != nil {
+		slog.ErrorContext(ctx, "error on method SaveRefreshToken auth in repository layer", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// FindRefreshToken implements AuthRepository.
+func (r *AuthRepositoryImpl) FindRefreshToken(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	query := `
+		SELECT
+			id, token_hash, employee_code, family_id, expires_at, revoked_at, replaced_by, created_at
+		FROM
+			refresh_token
+		WHERE
+			token_hash = $1`
+
+	token := &models.RefreshToken{}
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, tokenHash).Scan(
+		&token.ID,
+		&token.TokenHash,
+		&token.EmployeeCode,
+		&token.FamilyID,
+		&token.ExpiresAt,
+		&token.RevokedAt,
+		&token.ReplacedBy,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRefreshTokenNotFound
+		}
+		slog.ErrorContext(ctx, "error on method FindRefreshToken auth in repository layer", "error", err)
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// RotateRefreshToken implements AuthRepository.
+func (r *AuthRepositoryImpl) RotateRefreshToken(ctx context.Context, oldID uint, next *models.RefreshToken) error {
+	tx, err := beginTx(ctx, r.db, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method RotateRefreshToken auth in repository layer when begin transaction", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	// 1. Simpan token pengganti
+	if err := insertRefreshToken(ctx, tx, next); err != nil {
+		slog.ErrorContext(ctx, "error on method RotateRefreshToken auth in repository layer when insert token", "error", err)
+		return err
+	}
+
+	// 2. Cabut token lama; kondisi revoked_at IS NULL membuat dua request refresh
+	//    yang bersamaan dengan token yang sama hanya berhasil satu kali
+	result, err := tx.ExecContext(ctx, `
+		UPDATE
+			refresh_token
+		SET
+			revoked_at = CURRENT_TIMESTAMP, replaced_by = $2
+		WHERE
+			id = $1 AND revoked_at IS NULL`,
+		oldID, next.ID,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method RotateRefreshToken auth in repository layer when revoke token", "error", err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrRefreshTokenRevoked
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "error on method RotateRefreshToken auth in repository layer when commit", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// RevokeRefreshFamily implements AuthRepository.
+func (r *AuthRepositoryImpl) RevokeRefreshFamily(ctx context.Context, familyID string) error {
+	query := `UPDATE refresh_token SET revoked_at = CURRENT_TIMESTAMP WHERE family_id = $1 AND revoked_at IS NULL`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, familyID); err != nil {
+		slog.ErrorContext(ctx, "error on method RevokeRefreshFamily auth in repository layer", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// RevokeAccessToken implements AuthRepository.
+func (r *AuthRepositoryImpl) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	query := `INSERT INTO revoked_token (jti, expires_at) VALUES ($1, $2) ON CONFLICT (jti) DO NOTHING`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, jti, expiresAt); err != nil {
+		slog.ErrorContext(ctx, "error on method RevokeAccessToken auth in repository layer", "error", err)
+		return err
+	}
+
+	// token yang sudah kedaluwarsa tidak perlu dicatat lagi karena pasti ditolak saat verifikasi
+	if _, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM revoked_token WHERE expires_at < CURRENT_TIMESTAMP`); err != nil {
+		slog.ErrorContext(ctx, "error on method RevokeAccessToken auth in repository layer when cleanup", "error", err)
+	}
+
+	return nil
+}
+
+// IsAccessTokenRevoked implements AuthRepository.
+func (r *AuthRepositoryImpl) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM revoked_token WHERE jti = $1)`
+
+	var revoked bool
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, jti).Scan(&revoked); err != nil {
+		slog.ErrorContext(ctx, "error on method IsAccessTokenRevoked auth in repository layer", "error", err)
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+// insertRefreshToken menyimpan refresh token menggunakan koneksi q
+func insertRefreshToken(ctx context.Context, q dbtx, token *models.RefreshToken) error {
+	query := `
+		INSERT INTO refresh_token
+			(token_hash, employee_code, family_id, expires_at)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING
+			id, created_at`
+
+	err := q.QueryRowContext(ctx, query,
+		token.TokenHash,
+		token.EmployeeCode,
+		token.FamilyID,
+		token.ExpiresAt,
+	).Scan(&token.ID, &token.CreatedAt)
+
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) {
+			return ErrEmployeeNotFound
+		}
+		return err
+	}
+
+	return nil
+}
@@ -3,9 +3,11 @@ package repository
 import (
 	"context"
 	"database/sql"
-	"log"
+	"errors"
+	"log/slog"
 
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
 )
 
 type CategoryRepositoryImpl struct {
@@ -16,55 +18,64 @@ type CategoryRepositoryImpl struct {
 func (c *CategoryRepositoryImpl) Delete(ctx context.Context, id int) error {
 	query := `DELETE FROM category WHERE id = $1`
 
-	_, err := c.db.ExecContext(ctx, query, id)
+	result, err := c.db.ExecContext(ctx, query, id)
 	if err != nil {
-		log.Println("error on delete Category in repository layer", err)
-		return err
+		slog.ErrorContext(ctx, "error on delete Category in repository layer", "error", err)
+		return dbError(err, nil)
 	}
 
-	return nil
+	return notFoundIfNoRows(result, ErrCategoryNotFound)
+}
+
+// categoryList adalah sort dan filter yang didukung FindAll category
+var categoryList = listSpec{
+	columns:     `id, name`,
+	from:        `category`,
+	key:         `id`,
+	defaultSort: "id",
+	sorts: map[string]string{
+		"id":   `id`,
+		"name": `name`,
+	},
+	filters: map[string]listFilter{
+		"name": {column: `name`, kind: filterContains},
+	},
 }
 
 // FindAll implements CategoryRepository.
-func (c *CategoryRepositoryImpl) FindAll(ctx context.Context) ([]*models.Category, error) {
-	query := `SELECT id , name FROM category`
+func (c *CategoryRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.Category, pagination.Page, error) {
+	return findList(ctx, c.db, "FindAll category", categoryList, p, func(rows *sql.Rows, extra ...any) (*models.Category, error) {
+		category := &models.Category{}
+		err := rows.Scan(append([]any{&category.ID, &category.Name}, extra...)...)
+		return category, err
+	})
+}
 
-	rows, err := c.db.QueryContext(ctx, query)
+// FindById implements CategoryRepository.
+func (c *CategoryRepositoryImpl) FindById(ctx context.Context, id int) (*models.Category, error) {
+	query := `SELECT id, name FROM category WHERE id = $1`
 
+	category := &models.Category{}
+	err := c.db.QueryRowContext(ctx, query, id).Scan(&category.ID, &category.Name)
 	if err != nil {
-		log.Println("error on FindAll Category in repository layer", err)
-		return nil, err
-	}
-
-	defer rows.Close()
-
-	var categorys []*models.Category
-	for rows.Next() {
-		category := &models.Category{}
-		if err := rows.Scan(&category.ID, &category.Name); err != nil {
-			log.Println("error on FindAll Category in repository layer", err)
-			return nil, err
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.ErrorContext(ctx, "error on FindById Category in repository layer", "error", err)
 		}
-
-		categorys = append(categorys, category)
+		return nil, dbError(err, ErrCategoryNotFound)
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return categorys, nil
+	return category, nil
 }
 
 // Save implements CategoryRepository.
 func (c *CategoryRepositoryImpl) Save(ctx context.Context, category *models.Category) error {
-	query := `INSERT INTO category (name) VALUES ($1)`
+	query := `INSERT INTO category (name) VALUES ($1) RETURNING id`
 
-	_, err := c.db.ExecContext(ctx, query, category.Name)
+	err := c.db.QueryRowContext(ctx, query, category.Name).Scan(&category.ID)
 
 	if err != nil {
-		log.Println("error on Save Category in repository layer", err)
-		return err
+		slog.ErrorContext(ctx, "error on Save Category in repository layer", "error", err)
+		return dbError(err, nil)
 	}
 
 	return nil
@@ -74,14 +85,14 @@ func (c *CategoryRepositoryImpl) Save(ctx context.Context, category *models.Cate
 func (c *CategoryRepositoryImpl) Update(ctx context.Context, category *models.Category) error {
 	query := `UPDATE category SET name = $1 WHERE id = $2`
 
-	_, err := c.db.ExecContext(ctx, query, category.Name, category.ID)
+	result, err := c.db.ExecContext(ctx, query, category.Name, category.ID)
 
 	if err != nil {
-		log.Println("error on Update Category in repository layer", err)
-		return err
+		slog.ErrorContext(ctx, "error on Update Category in repository layer", "error", err)
+		return dbError(err, nil)
 	}
 
-	return nil
+	return notFoundIfNoRows(result, ErrCategoryNotFound)
 }
 
 func NewCategoryRepository(db *sql.DB) CategoryRepository {
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+)
+
+// dbtx adalah method yang dimiliki *sql.DB maupun *sql.Tx, sehingga query
+// yang sama bisa dijalankan di luar atau di dalam transaksi database
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// txKey adalah key context untuk transaksi yang dibuka TxManager
+type txKey struct{}
+
+// txState adalah transaksi yang sedang berjalan beserta pool asalnya. Repository
+// hanya memakai transaksi ini jika dibuat dari *sql.DB yang sama.
+type txState struct {
+	db         *sql.DB
+	tx         *sql.Tx
+	savepoints atomic.Int64
+}
+
+func txFrom(ctx context.Context, db *sql.DB) (*txState, bool) {
+	state, ok := ctx.Value(txKey{}).(*txState)
+	if !ok || state.db != db {
+		return nil, false
+	}
+	return state, true
+}
+
+// conn mengembalikan transaksi TxManager yang dibawa ctx, atau db jika tidak ada.
+// Semua query repository dijalankan lewat conn supaya otomatis ikut transaksi pemanggil.
+func conn(ctx context.Context, db *sql.DB) dbtx {
+	if state, ok := txFrom(ctx, db); ok {
+		return state.tx
+	}
+	return db
+}
+
+// scopedTx adalah transaksi milik satu method repository (atau WithinTx bersarang).
+// Di dalam transaksi TxManager, scope-nya berupa SAVEPOINT sehingga kegagalan method
+// ini hanya membatalkan perubahannya sendiri; commit sebenarnya dilakukan TxManager.
+type scopedTx struct {
+	*sql.Tx
+	ctx       context.Context
+	savepoint string // kosong jika transaksi dibuka sendiri
+	done      bool
+}
+
+// beginTx menggantikan db.BeginTx di repository: membuka transaksi baru, atau
+// SAVEPOINT jika ctx sudah membawa transaksi dari TxManager
+func beginTx(ctx context.Context, db *sql.DB, opts *sql.TxOptions) (*scopedTx, error) {
+	state, ok := txFrom(ctx, db)
+	if !ok {
+		tx, err := db.BeginTx(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &scopedTx{Tx: tx, ctx: ctx}, nil
+	}
+
+	name := fmt.Sprintf("wms_sp_%d", state.savepoints.Add(1))
+	if _, err := state.tx.ExecContext(ctx, `SAVEPOINT `+name); err != nil {
+		return nil, err
+	}
+	return &scopedTx{Tx: state.tx, ctx: ctx, savepoint: name}, nil
+}
+
+func (t *scopedTx) Commit() error {
+	if t.savepoint == "" {
+		return t.Tx.Commit()
+	}
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+	_, err := t.Tx.ExecContext(t.ctx, `RELEASE SAVEPOINT `+t.savepoint)
+	return err
+}
+
+// Rollback aman dipanggil lewat defer setelah Commit, sama seperti *sql.Tx
+func (t *scopedTx) Rollback() error {
+	if t.savepoint == "" {
+		return t.Tx.Rollback()
+	}
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+	_, err := t.Tx.ExecContext(t.ctx, `ROLLBACK TO SAVEPOINT `+t.savepoint)
+	return err
+}
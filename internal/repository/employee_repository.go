@@ -6,6 +6,7 @@ import (
 	"errors"
 
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
 )
 
 type EmployeeRepositoryImpl struct {
@@ -18,108 +19,98 @@ func NewEmployeeRepository(db *sql.DB) EmployeeRepository {
 	}
 }
 
-// FindAllByWarehouse implements EmployeeRepository.
-func (r *EmployeeRepositoryImpl) FindAll(ctx context.Context) ([]*models.Employee, error) {
-	query := `
-		SELECT 
-			 user_id, employee_name, employee_code, id_role, warehouse_code 
-		FROM 
-			employee limit 50`
-
-	rows, err := r.db.QueryContext(ctx, query)
-	if err != nil {
-		return nil, err
-	}
-
-	defer rows.Close()
+// employeeList adalah sort dan filter yang didukung FindAll employee
+var employeeList = listSpec{
+	columns:     `user_id, employee_name, employee_code, id_role, warehouse_code`,
+	from:        `employee`,
+	key:         `id`,
+	defaultSort: "id",
+	sorts: map[string]string{
+		"id":   `id`,
+		"name": `COALESCE(employee_name, '')`,
+		"code": `COALESCE(employee_code, '')`,
+	},
+	filters: map[string]listFilter{
+		"name":           {column: `employee_name`, kind: filterContains},
+		"warehouse_code": {column: `warehouse_code`, kind: filterEquals},
+		"id_role":        {column: `id_role`, kind: filterInt},
+	},
+}
 
-	var employees []*models.Employee
-	for rows.Next() {
+// FindAll implements EmployeeRepository.
+func (r *EmployeeRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.Employee, pagination.Page, error) {
+	return findList(ctx, conn(ctx, r.db), "FindAll employee", employeeList, p, func(rows *sql.Rows, extra ...any) (*models.Employee, error) {
 		emp := &models.Employee{}
-		if err := rows.Scan(
+		err := rows.Scan(append([]any{
 			&emp.UserID,
 			&emp.EmployeeName,
 			&emp.EmployeeCode,
 			&emp.IDRole,
 			&emp.WarehouseCode,
-		); err != nil {
-			return nil, err
-		}
-		employees = append(employees, emp)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return employees, nil
+		}, extra...)...)
+		return emp, err
+	})
 }
 
-// Implementasi method FindAllByWarehouse
-func (r *EmployeeRepositoryImpl) FindAllByWarehouse(ctx context.Context, warehouseCode string) ([]*models.Employee, error) {
+// Implementasi method FindById
+func (r *EmployeeRepositoryImpl) FindById(ctx context.Context, employee_code string) (*models.Employee, error) {
 	query := `
 		SELECT 
-			user_id, employee_name, employee_code, id_role, warehouse_code 
+			user_id, employee_name, password , employee_code, id_role, warehouse_code 
 		FROM 
 			employee 
 		WHERE 
-			warehouse_code = $1`
+			employee_code = $1`
 
-	rows, err := r.db.QueryContext(ctx, query, warehouseCode)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
+	row := conn(ctx, r.db).QueryRowContext(ctx, query, employee_code)
+	emp := &models.Employee{}
 
-	var employees []*models.Employee
-	for rows.Next() {
-		emp := &models.Employee{}
-		if err := rows.Scan(
-			&emp.UserID,
-			&emp.EmployeeName,
-			&emp.EmployeeCode,
-			&emp.IDRole,
-			&emp.WarehouseCode,
-		); err != nil {
-			return nil, err
+	if err := row.Scan(
+		&emp.UserID,
+		&emp.EmployeeName,
+		&emp.Password,
+		&emp.EmployeeCode,
+		&emp.IDRole,
+		&emp.WarehouseCode,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEmployeeNotFound.Wrap(err)
 		}
-		employees = append(employees, emp)
-	}
-
-	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return employees, nil
+	return emp, nil
 }
 
-// Implementasi method FindById
-func (r *EmployeeRepositoryImpl) FindById(ctx context.Context, employee_code string) (*models.Employee, error) {
+// Implementasi method FindByLogin
+func (r *EmployeeRepositoryImpl) FindByLogin(ctx context.Context, login string) (*models.Employee, error) {
 	query := `
-		SELECT 
-			user_id, employee_name, password , employee_code, id_role, warehouse_code 
-		FROM 
-			employee 
-		WHERE 
-			employee_code = $1`
+		SELECT
+			e.user_id, e.employee_name, e.password, e.employee_code, e.id_role, r.role_name, e.warehouse_code
+		FROM
+			employee e
+		JOIN
+			role r ON r.id = e.id_role
+		WHERE
+			e.user_id = $1 OR e.employee_code = $1`
 
-	row := r.db.QueryRowContext(ctx, query, employee_code)
 	emp := &models.Employee{}
-
-	if err := row.Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, login).Scan(
 		&emp.UserID,
 		&emp.EmployeeName,
 		&emp.Password,
 		&emp.EmployeeCode,
 		&emp.IDRole,
+		&emp.Role.RoleName,
 		&emp.WarehouseCode,
-	); err != nil {
+	)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			// Jika tidak ditemukan, kembalikan nil, nil (atau error spesifik jika perlu)
-			return nil, errors.New("employee not found")
+			return nil, ErrEmployeeNotFound
 		}
 		return nil, err
 	}
+	emp.Role.ID = emp.IDRole
 
 	return emp, nil
 }
@@ -135,7 +126,7 @@ func (r *EmployeeRepositoryImpl) Save(ctx context.Context, employee *models.Empl
 			id`
 
 	// Menggunakan QueryRowContext karena kita butuh ID yang dikembalikan (RETURNING id)
-	err := r.db.QueryRowContext(ctx, query,
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
 		employee.UserID,
 		employee.EmployeeName,
 		employee.Password,
@@ -145,7 +136,7 @@ func (r *EmployeeRepositoryImpl) Save(ctx context.Context, employee *models.Empl
 	).Scan(&employee.ID) // Scan ID baru ke dalam struct employee
 
 	if err != nil {
-		return err
+		return dbError(err, nil)
 	}
 
 	return nil
@@ -163,7 +154,7 @@ func (r *EmployeeRepositoryImpl) Update(ctx context.Context, employee *models.Em
 		WHERE 
 			employee_code = $5`
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
 		employee.EmployeeName,
 		employee.Password,
 		employee.IDRole,
@@ -172,7 +163,7 @@ func (r *EmployeeRepositoryImpl) Update(ctx context.Context, employee *models.Em
 	)
 
 	if err != nil {
-		return err
+		return dbError(err, nil)
 	}
 
 	// Cek apakah ada baris yang ter-update
@@ -181,7 +172,7 @@ func (r *EmployeeRepositoryImpl) Update(ctx context.Context, employee *models.Em
 		return err
 	}
 	if rowsAffected == 0 {
-		return errors.New("no rows updated, employee not found")
+		return ErrEmployeeNotFound
 	}
 
 	return nil
@@ -191,9 +182,9 @@ func (r *EmployeeRepositoryImpl) Update(ctx context.Context, employee *models.Em
 func (r *EmployeeRepositoryImpl) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM employee WHERE employee_code = $1`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
-		return err
+		return dbError(err, nil)
 	}
 
 	// Cek apakah ada baris yang ter-delete
@@ -202,7 +193,7 @@ func (r *EmployeeRepositoryImpl) Delete(ctx context.Context, id string) error {
 		return err
 	}
 	if rowsAffected == 0 {
-		return errors.New("no rows deleted, employee not found")
+		return ErrEmployeeNotFound
 	}
 
 	return nil
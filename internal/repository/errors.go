@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+	"github.com/lib/pq"
+)
+
+// Error domain yang bisa dicek oleh layer di atas repository dengan errors.Is.
+// Kind setiap error menentukan HTTP status yang dikirim ke client.
+var (
+	ErrProductNotFound   = apperror.NotFound("product_not_found", "product not found")
+	ErrCategoryNotFound  = apperror.NotFound("category_not_found", "category not found")
+	ErrSizeNotFound      = apperror.NotFound("size_not_found", "size not found")
+	ErrWarehouseNotFound = apperror.NotFound("warehouse_not_found", "warehouse not found")
+
+	ErrProductDetailNotFound = apperror.NotFound("product_variant_not_found", "product variant not found")
+	ErrProductDetailExists   = apperror.Conflict("product_variant_exists", "product already has a variant for this size")
+	ErrBarcodeExists         = apperror.Conflict("barcode_exists", "barcode already used by another product variant")
+
+	ErrInsufficientStock = apperror.Conflict("insufficient_stock", "insufficient stock")
+
+	ErrRoleNotFound   = apperror.NotFound("role_not_found", "role not found")
+	ErrRoleNameExists = apperror.Conflict("role_name_exists", "role name already exists")
+	ErrRoleInUse      = apperror.Conflict("role_in_use", "role is still assigned to one or more employees")
+
+	ErrEmployeeNotFound         = apperror.NotFound("employee_not_found", "employee not found")
+	ErrRefreshTokenNotFound     = apperror.NotFound("refresh_token_not_found", "refresh token not found")
+	ErrRefreshTokenRevoked      = apperror.Conflict("refresh_token_revoked", "refresh token has been revoked")
+	ErrTransactionNotFound      = apperror.NotFound("transaction_not_found", "transaction not found")
+	ErrTransactionStatusChanged = apperror.Conflict("transaction_status_changed", "transaction status has changed, reload and try again")
+	ErrTransactionItemNotFound  = apperror.Validation("transaction_item_not_found", "product variant is not part of this transaction")
+	ErrReceiveExceedsQuantity   = apperror.Validation("receive_exceeds_quantity", "received quantity exceeds the remaining quantity of the line")
+	ErrScanExceedsQuantity      = apperror.Conflict("scan_exceeds_quantity", "scanned quantity exceeds the quantity of the line")
+
+	ErrTxConflict = apperror.Conflict("transaction_conflict", "data was changed by another request at the same time, try again")
+
+	ErrNoFieldsToUpdate = apperror.Validation("no_fields_to_update", "no valid fields to update")
+
+	// error umum hasil dbError jika tidak ada error yang lebih spesifik
+	ErrDuplicate        = apperror.Conflict("duplicate", "data already exists")
+	ErrStillReferenced  = apperror.Conflict("still_referenced", "data is still used by other data")
+	ErrInvalidReference = apperror.Validation("invalid_reference", "referenced data does not exist")
+)
+
+// kode error PostgreSQL yang ditangani secara khusus
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+	// pgSerializationFailure dikembalikan transaksi REPEATABLE READ/SERIALIZABLE yang
+	// bertabrakan dengan transaksi lain; transaksinya aman untuk diulang
+	pgSerializationFailure = "40001"
+)
+
+// isPgError mengecek apakah err berasal dari PostgreSQL dengan kode tertentu
+func isPgError(err error, code string) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return string(pqErr.Code) == code
+	}
+	return false
+}
+
+// pgConstraint mengembalikan nama constraint yang dilanggar (jika ada)
+func pgConstraint(err error) string {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Constraint
+	}
+	return ""
+}
+
+// dbError mengubah error database menjadi error domain: sql.ErrNoRows menjadi notFound,
+// 23505 menjadi ErrDuplicate dan 23503 menjadi ErrStillReferenced (saat update/delete
+// baris yang masih dipakai) atau ErrInvalidReference (saat insert/update dengan
+// referensi yang tidak ada). Error lain dikembalikan apa adanya.
+func dbError(err error, notFound *apperror.Error) error {
+	if notFound != nil && errors.Is(err, sql.ErrNoRows) {
+		return notFound.Wrap(err)
+	}
+
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch string(pqErr.Code) {
+	case pgUniqueViolation:
+		return ErrDuplicate.Wrap(err)
+	case pgForeignKeyViolation:
+		if strings.HasPrefix(pqErr.Message, "update or delete") {
+			return ErrStillReferenced.Wrap(err)
+		}
+		return ErrInvalidReference.Wrap(err)
+	}
+
+	return err
+}
+
+// notFoundIfNoRows mengembalikan notFound jika UPDATE/DELETE tidak mengenai baris apa pun
+func notFoundIfNoRows(result sql.Result, notFound *apperror.Error) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return notFound
+	}
+	return nil
+}
+
+// invalidReference dipakai jika data yang tidak ditemukan berasal dari body request
+// (foreign key), sehingga client mendapat 422 dan bukan 404
+func invalidReference(notFound *apperror.Error, cause error) error {
+	return notFound.WithKind(apperror.KindValidation).Wrap(cause)
+}
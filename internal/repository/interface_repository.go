@@ -2,52 +2,138 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
 )
 
 type EmployeeRepository interface {
-	FindAll(ctx context.Context) ([]*models.Employee, error)
-	FindAllByWarehouse(ctx context.Context, warehouseCode string) ([]*models.Employee, error)
+	// FindAll mendukung sort id, name, code dan filter name, warehouse_code, id_role
+	FindAll(ctx context.Context, p pagination.Params) ([]*models.Employee, pagination.Page, error)
 	FindById(ctx context.Context, id string) (*models.Employee, error)
+	// FindByLogin mencari employee berdasarkan user_id atau employee_code beserta nama role-nya
+	FindByLogin(ctx context.Context, login string) (*models.Employee, error)
 	Save(ctx context.Context, employee *models.Employee) error
 	Update(ctx context.Context, employee *models.Employee) error
 	Delete(ctx context.Context, id string) error
 }
 
+type AuthRepository interface {
+	SaveRefreshToken(ctx context.Context, token *models.RefreshToken) error
+	FindRefreshToken(ctx context.Context, tokenHash string) (*models.RefreshToken, error)
+	// RotateRefreshToken mencabut token oldID dan menyimpan next sebagai penggantinya.
+	// Jika oldID sudah dicabut lebih dulu (dipakai dua kali) dikembalikan ErrRefreshTokenRevoked.
+	RotateRefreshToken(ctx context.Context, oldID uint, next *models.RefreshToken) error
+	RevokeRefreshFamily(ctx context.Context, familyID string) error
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+	IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
 type RoleRepository interface {
-	FindAll(ctx context.Context) ([]*models.Role, error)
+	// FindAll mendukung sort id, name dan filter name
+	FindAll(ctx context.Context, p pagination.Params) ([]*models.Role, pagination.Page, error)
 	FindById(ctx context.Context, id int) (*models.Role, error)
+	// Save menyimpan role beserta permission-nya dalam satu transaksi database
 	Save(ctx context.Context, role *models.Role) error
+	Update(ctx context.Context, role *models.Role) error
+	// SetPermissions mengganti seluruh permission role dengan permissions
+	SetPermissions(ctx context.Context, id int, permissions []string) error
+	// Delete mengembalikan ErrRoleInUse jika role masih dipakai employee
 	Delete(ctx context.Context, id int) error
+	// FindAllPermissions mengembalikan permission setiap role, dipakai untuk cache otorisasi
+	FindAllPermissions(ctx context.Context) (map[uint][]string, error)
 }
 
 type ProductRepository interface {
-	FindAll(ctx context.Context) ([]*models.Product, error)
+	// FindAll mendukung sort id, name, code, price dan filter name, code, id_category, category
+	FindAll(ctx context.Context, p pagination.Params) ([]*models.Product, pagination.Page, error)
 	FindById(ctx context.Context, id int) (*models.Product, error)
+	FindByCode(ctx context.Context, code string) (*models.Product, error)
 	Save(ctx context.Context, product *models.Product) error
 	Update(ctx context.Context, product *models.Product) error
 	Delete(ctx context.Context, id int) error
 }
 
+type ProductDetailRepository interface {
+	FindAllByProduct(ctx context.Context, codeProduct string) ([]*models.ProductDetail, error)
+	FindById(ctx context.Context, id int) (*models.ProductDetail, error)
+	FindByBarcode(ctx context.Context, barcode string) (*models.ProductDetail, error)
+	ExistsByProductAndSize(ctx context.Context, codeProduct string, idSize int) (bool, error)
+	Save(ctx context.Context, detail *models.ProductDetail) error
+	Delete(ctx context.Context, id int) error
+}
+
+type InventoryRepository interface {
+	FindAllByWarehouse(ctx context.Context, codeWarehouse string) ([]*models.Inventory, error)
+	FindAllByProduct(ctx context.Context, codeProduct string) ([]*models.Inventory, error)
+	FindAllByVariant(ctx context.Context, codeProduct string, idSize int) ([]*models.Inventory, error)
+	// Adjust menambah (delta positif) atau mengurangi (delta negatif) stok secara atomik
+	Adjust(ctx context.Context, codeProduct string, idSize int, codeWarehouse string, delta int) (*models.Inventory, error)
+}
+
+type TransactionRepository interface {
+	FindByCode(ctx context.Context, code string) (*models.Transaction, error)
+	NextCodeSequence(ctx context.Context) (int64, error)
+	// Save menyimpan header beserta seluruh detail dalam satu transaksi database
+	Save(ctx context.Context, trx *models.Transaction) error
+	// SaveWithReservation menyimpan dokumen yang mengambil stok dari codeWarehouse.
+	// Jika ada baris yang melebihi stok tersedia, dikembalikan *StockShortageError.
+	SaveWithReservation(ctx context.Context, trx *models.Transaction, codeWarehouse string) error
+	// UpdateStatus memindahkan status dari fromStatus ke toStatus dan menjalankan
+	// perubahan stok dalam satu transaksi database
+	UpdateStatus(ctx context.Context, id uint, fromStatus, toStatus uint, movements []InventoryMovement) error
+	// Receive mencatat barang transfer yang diterima di codeWarehouse dan menambah stoknya.
+	// Dokumen otomatis Completed jika seluruh baris sudah diterima penuh.
+	Receive(ctx context.Context, id uint, codeWarehouse string, items []models.DetailTransaction) error
+	// Scan menambah scanner_quantity baris yang barcode-nya cocok secara atomik
+	// dan mengembalikan id_detail_product baris tersebut
+	Scan(ctx context.Context, id uint, barcode string, quantity int) (uint, error)
+}
+
 type WarehouseRepository interface {
-	FindAll(ctx context.Context) ([]*models.Warehouse, error)
+	// FindAll mendukung sort id, name, code dan filter name, code, location
+	FindAll(ctx context.Context, p pagination.Params) ([]*models.Warehouse, pagination.Page, error)
 	FindById(ctx context.Context, id string) (*models.Warehouse, error)
 	Save(ctx context.Context, warehouse *models.Warehouse) error
 	Update(ctx context.Context, warehouse map[string]any, code string) error
 	Delete(ctx context.Context, id string) error
+	ExistsByCode(ctx context.Context, code string) (bool, error)
 }
 
 type CategoryRepository interface {
-	FindAll(ctx context.Context) ([]*models.Category, error)
+	// FindAll mendukung sort id, name dan filter name
+	FindAll(ctx context.Context, p pagination.Params) ([]*models.Category, pagination.Page, error)
+	FindById(ctx context.Context, id int) (*models.Category, error)
 	Save(ctx context.Context, category *models.Category) error
 	Update(ctx context.Context, category *models.Category) error
 	Delete(ctx context.Context, id int) error
 }
 
 type SizeRepository interface {
-	FindAll(ctx context.Context) ([]*models.Size, error)
+	// FindAll mendukung sort id, name dan filter name
+	FindAll(ctx context.Context, p pagination.Params) ([]*models.Size, pagination.Page, error)
+	FindById(ctx context.Context, id int) (*models.Size, error)
 	Save(ctx context.Context, size *models.Size) error
 	Update(ctx context.Context, size *models.Size) error
 	Delete(ctx context.Context, id int) error
 }
+
+type AuditRepository interface {
+	// FindAll mendukung sort id, created_at (default -id) dan filter actor, entity_type,
+	// entity_key, action, request_id, since, until
+	FindAll(ctx context.Context, p pagination.Params) ([]*models.AuditLog, pagination.Page, error)
+	Save(ctx context.Context, entry *models.AuditLog) error
+}
+
+// TxManager menjalankan beberapa pemanggilan repository dalam satu transaksi database.
+// Semua repository yang dipanggil dengan ctx milik fn otomatis memakai transaksi yang
+// sama; transaksi di-commit jika fn mengembalikan nil dan di-rollback jika tidak.
+//
+// fn bisa dijalankan lebih dari sekali (serialization failure diulang), jadi fn tidak
+// boleh punya efek samping di luar database. WithinTx di dalam fn berjalan sebagai
+// SAVEPOINT: error-nya hanya membatalkan perubahan di dalamnya.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+	WithinTxOptions(ctx context.Context, opts TxOptions, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"sort"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+)
+
+type InventoryRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewInventoryRepository(db *sql.DB) InventoryRepository {
+	return &InventoryRepositoryImpl{
+		db: db,
+	}
+}
+
+// stok dibaca bersama nama product, size dan warehouse
+const inventorySelect = `
+		SELECT
+			i.id, i.quantity, i.code_product, i.id_size, i.code_warehouse,
+			p.product_name, s.name, w.warehouse_name
+		FROM
+			inventory i
+		JOIN
+			product p ON p.product_code = i.code_product
+		JOIN
+			size s ON s.id = i.id_size
+		JOIN
+			warehouse w ON w.warehouse_code = i.code_warehouse`
+
+// FindAllByWarehouse implements InventoryRepository.
+func (r *InventoryRepositoryImpl) FindAllByWarehouse(ctx context.Context, codeWarehouse string) ([]*models.Inventory, error) {
+	query := inventorySelect + ` WHERE i.code_warehouse = $1 ORDER BY p.product_name, i.id_size`
+
+	return r.queryInventories(ctx, "FindAllByWarehouse", query, codeWarehouse)
+}
+
+// FindAllByProduct implements InventoryRepository.
+func (r *InventoryRepositoryImpl) FindAllByProduct(ctx context.Context, codeProduct string) ([]*models.Inventory, error) {
+	query := inventorySelect + ` WHERE i.code_product = $1 ORDER BY i.code_warehouse, i.id_size`
+
+	return r.queryInventories(ctx, "FindAllByProduct", query, codeProduct)
+}
+
+// FindAllByVariant implements InventoryRepository.
+func (r *InventoryRepositoryImpl) FindAllByVariant(ctx context.Context, codeProduct string, idSize int) ([]*models.Inventory, error) {
+	query := inventorySelect + ` WHERE i.code_product = $1 AND i.id_size = $2 ORDER BY i.code_warehouse`
+
+	return r.queryInventories(ctx, "FindAllByVariant", query, codeProduct, idSize)
+}
+
+// Adjust implements InventoryRepository.
+func (r *InventoryRepositoryImpl) Adjust(ctx context.Context, codeProduct string, idSize int, codeWarehouse string, delta int) (*models.Inventory, error) {
+	tx, err := beginTx(ctx, r.db, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method Adjust inventory in repository layer when begin transaction", "error", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	inv, err := adjustInventory(ctx, tx, codeProduct, uint(idSize), codeWarehouse, delta)
+	if err != nil {
+		return nil, err
+	}
+
+	// baca ulang dalam transaksi yang sama supaya nama product/size/warehouse ikut terisi
+	inv, err = scanInventoryRow(tx.QueryRowContext(ctx, inventorySelect+` WHERE i.id = $1`, inv.ID))
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method Adjust inventory in repository layer", "error", err)
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "error on method Adjust inventory in repository layer when commit", "error", err)
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// adjustInventory mengubah quantity satu baris inventory dengan row lock (SELECT ... FOR UPDATE).
+// Fungsi ini harus dipanggil di dalam transaksi; lock dilepas saat transaksi commit/rollback,
+// sehingga scanner yang berjalan bersamaan tidak bisa membuat stok minus atau saling menimpa.
+func adjustInventory(ctx context.Context, q dbtx, codeProduct string, idSize uint, codeWarehouse string, delta int) (*models.Inventory, error) {
+	// 1. Pastikan baris ada jika stok akan bertambah
+	if delta > 0 {
+		_, err := q.ExecContext(ctx, `
+			INSERT INTO inventory
+				(code_product, id_size, code_warehouse, quantity)
+			VALUES
+				($1, $2, $3, 0)
+			ON CONFLICT (code_product, id_size, code_warehouse) DO NOTHING`,
+			codeProduct, idSize, codeWarehouse,
+		)
+		if err != nil {
+			return nil, mapInventoryError(err)
+		}
+	}
+
+	// 2. Kunci baris inventory
+	inv := &models.Inventory{
+		CodeProduct:   codeProduct,
+		IDSize:        idSize,
+		CodeWarehouse: codeWarehouse,
+	}
+
+	err := q.QueryRowContext(ctx, `
+		SELECT
+			id, quantity
+		FROM
+			inventory
+		WHERE
+			code_product = $1 AND id_size = $2 AND code_warehouse = $3
+		FOR UPDATE`,
+		codeProduct, idSize, codeWarehouse,
+	).Scan(&inv.ID, &inv.Quantity)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// belum pernah ada stok sama sekali
+			return nil, ErrInsufficientStock
+		}
+		slog.ErrorContext(ctx, "error on adjustInventory in repository layer when lock row", "error", err)
+		return nil, err
+	}
+
+	// 3. Stok tidak boleh minus
+	if inv.Quantity+delta < 0 {
+		return nil, ErrInsufficientStock
+	}
+
+	// 4. Simpan quantity baru
+	inv.Quantity += delta
+	if _, err := q.ExecContext(ctx, `UPDATE inventory SET quantity = $1 WHERE id = $2`, inv.Quantity, inv.ID); err != nil {
+		slog.ErrorContext(ctx, "error on adjustInventory in repository layer when update quantity", "error", err)
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// InventoryMovement adalah perubahan stok satu variant di satu warehouse
+type InventoryMovement struct {
+	CodeProduct   string `json:"code_product"`
+	IDSize        uint   `json:"id_size"`
+	CodeWarehouse string `json:"code_warehouse"`
+	Delta         int    `json:"delta"`
+}
+
+// applyMovements menjalankan beberapa perubahan stok di dalam transaksi yang sama.
+// Baris dikunci dengan urutan yang konsisten agar dua transaksi yang menyentuh
+// variant yang sama tidak saling deadlock.
+func applyMovements(ctx context.Context, q dbtx, movements []InventoryMovement) error {
+	sorted := make([]InventoryMovement, len(movements))
+	copy(sorted, movements)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.CodeWarehouse != b.CodeWarehouse {
+			return a.CodeWarehouse < b.CodeWarehouse
+		}
+		if a.CodeProduct != b.CodeProduct {
+			return a.CodeProduct < b.CodeProduct
+		}
+		return a.IDSize < b.IDSize
+	})
+
+	for _, m := range sorted {
+		if _, err := adjustInventory(ctx, q, m.CodeProduct, m.IDSize, m.CodeWarehouse, m.Delta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mapInventoryError menerjemahkan pelanggaran foreign key inventory ke error yang lebih jelas
+func mapInventoryError(err error) error {
+	if !isPgError(err, pgForeignKeyViolation) {
+		return err
+	}
+
+	switch pgConstraint(err) {
+	case "inventory_code_warehouse_fkey":
+		return invalidReference(ErrWarehouseNotFound, err)
+	case "inventory_id_size_fkey":
+		return invalidReference(ErrSizeNotFound, err)
+	default:
+		return invalidReference(ErrProductNotFound, err)
+	}
+}
+
+func (r *InventoryRepositoryImpl) queryInventories(ctx context.Context, method, query string, args ...any) ([]*models.Inventory, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on inventory method in repository layer", "method", method, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var inventories []*models.Inventory
+	for rows.Next() {
+		inv, err := scanInventoryRow(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "error on inventory method in repository layer", "method", method, "error", err)
+			return nil, err
+		}
+		inventories = append(inventories, inv)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return inventories, nil
+}
+
+// scanInventoryRow membaca satu baris hasil inventorySelect
+func scanInventoryRow(row interface{ Scan(dest ...any) error }) (*models.Inventory, error) {
+	inv := &models.Inventory{}
+
+	err := row.Scan(
+		&inv.ID,
+		&inv.Quantity,
+		&inv.CodeProduct,
+		&inv.IDSize,
+		&inv.CodeWarehouse,
+		&inv.Product.ProductName,
+		&inv.Size.Name,
+		&inv.Warehouse.WarehouseName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inv.Product.ProductCode = inv.CodeProduct
+	inv.Size.ID = inv.IDSize
+
+	return inv, nil
+}
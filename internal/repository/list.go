@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+)
+
+// pgInvalidTextRepresentation muncul jika nilai cursor tidak cocok dengan tipe kolom sort
+const pgInvalidTextRepresentation = "22P02"
+
+type filterKind int
+
+const (
+	// filterEquals membandingkan kolom (sebagai text) dengan nilai filter
+	filterEquals filterKind = iota
+	// filterContains mencari nilai filter di dalam kolom tanpa membedakan huruf besar/kecil
+	filterContains
+	// filterInt membandingkan kolom integer; nilai filter harus berupa angka
+	filterInt
+	// filterSince dan filterUntil membatasi kolom waktu (>= dan <); nilai filter
+	// berupa RFC3339 atau tanggal YYYY-MM-DD
+	filterSince
+	filterUntil
+)
+
+type listFilter struct {
+	column string
+	kind   filterKind
+}
+
+// listSpec menjelaskan query list satu tabel: kolom yang dibaca, sort dan filter
+// yang boleh dipakai client. Ekspresi sort harus NOT NULL supaya cursor tetap benar.
+type listSpec struct {
+	columns     string
+	from        string
+	key         string // kolom id unik, dipakai sebagai tiebreaker sort dan isi cursor
+	sorts       map[string]string
+	defaultSort string
+	// defaultDesc mengurutkan turun jika client tidak mengirim sort, misalnya data terbaru dulu
+	defaultDesc bool
+	filters     map[string]listFilter
+}
+
+// findList menjalankan query list sesuai spesifikasi p dan mengembalikan data beserta
+// metadata halaman. scan harus ikut membaca extra (nilai sort dan key) di akhir baris.
+func findList[T any](
+	ctx context.Context,
+	q dbtx,
+	method string,
+	spec listSpec,
+	p pagination.Params,
+	scan func(rows *sql.Rows, extra ...any) (T, error),
+) ([]T, pagination.Page, error) {
+	page := pagination.Page{Limit: p.Limit, Page: p.Page}
+
+	sortName, desc := p.Sort, p.Desc
+	if sortName == "" {
+		sortName, desc = spec.defaultSort, spec.defaultDesc
+	}
+	sortExpr, ok := spec.sorts[sortName]
+	if !ok {
+		return nil, page, pagination.ErrInvalidSort
+	}
+
+	where, args, err := spec.where(p.Filters)
+	if err != nil {
+		return nil, page, err
+	}
+
+	countQuery := `SELECT count(*) FROM ` + spec.from + joinWhere(where)
+	if err := q.QueryRowContext(ctx, countQuery, args...).Scan(&page.Total); err != nil {
+		slog.ErrorContext(ctx, "error on method in repository layer when count rows", "method", method, "error", err)
+		return nil, page, err
+	}
+
+	op, direction := ">", "ASC"
+	if desc {
+		op, direction = "<", "DESC"
+	}
+
+	if p.Cursor != nil {
+		if p.Cursor.Sort != sortName || p.Cursor.Desc != desc {
+			return nil, page, pagination.ErrInvalidCursor
+		}
+		if _, err := strconv.ParseInt(p.Cursor.Key, 10, 64); err != nil {
+			return nil, page, pagination.ErrInvalidCursor
+		}
+
+		args = append(args, p.Cursor.Value, p.Cursor.Key)
+		where = append(where, fmt.Sprintf(
+			"(%[1]s %[3]s $%[4]d OR (%[1]s = $%[4]d AND %[2]s %[3]s $%[5]d))",
+			sortExpr, spec.key, op, len(args)-1, len(args),
+		))
+	}
+
+	// ambil satu baris lebih untuk mengetahui apakah masih ada halaman berikutnya
+	query := fmt.Sprintf(
+		`SELECT %s, (%s)::text, (%s)::text FROM %s%s ORDER BY %s %s, %s %s LIMIT %d OFFSET %d`,
+		spec.columns, sortExpr, spec.key, spec.from, joinWhere(where),
+		sortExpr, direction, spec.key, direction, p.Limit+1, p.Offset(),
+	)
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		if p.Cursor != nil && isPgError(err, pgInvalidTextRepresentation) {
+			return nil, page, pagination.ErrInvalidCursor
+		}
+		slog.ErrorContext(ctx, "error on method in repository layer", "method", method, "error", err)
+		return nil, page, err
+	}
+	defer rows.Close()
+
+	var (
+		items              []T
+		sortValue, key     string
+		lastValue, lastKey string
+	)
+	for rows.Next() {
+		item, err := scan(rows, &sortValue, &key)
+		if err != nil {
+			slog.ErrorContext(ctx, "error on method in repository layer", "method", method, "error", err)
+			return nil, page, err
+		}
+
+		if len(items) == p.Limit {
+			page.NextCursor = pagination.EncodeCursor(pagination.Cursor{
+				Sort:  sortName,
+				Desc:  desc,
+				Value: lastValue,
+				Key:   lastKey,
+			})
+			break
+		}
+
+		items = append(items, item)
+		lastValue, lastKey = sortValue, key
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, page, err
+	}
+
+	return items, page, nil
+}
+
+// where menyusun kondisi WHERE dari filter client; filter yang tidak dikenal ditolak
+func (s listSpec) where(filters map[string]string) ([]string, []any, error) {
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	// urutan tetap supaya query yang sama menghasilkan SQL yang sama
+	sort.Strings(keys)
+
+	var (
+		where []string
+		args  []any
+	)
+	for _, key := range keys {
+		f, ok := s.filters[key]
+		if !ok {
+			return nil, nil, invalidFilter(key)
+		}
+
+		value := filters[key]
+		switch f.kind {
+		case filterContains:
+			args = append(args, escapeLike(value))
+			where = append(where, fmt.Sprintf("%s ILIKE '%%' || $%d || '%%'", f.column, len(args)))
+		case filterInt:
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, nil, invalidFilter(key)
+			}
+			args = append(args, n)
+			where = append(where, fmt.Sprintf("%s = $%d", f.column, len(args)))
+		case filterSince, filterUntil:
+			t, err := parseFilterTime(value)
+			if err != nil {
+				return nil, nil, invalidFilter(key)
+			}
+			op := ">="
+			if f.kind == filterUntil {
+				op = "<"
+			}
+			args = append(args, t)
+			where = append(where, fmt.Sprintf("%s %s $%d", f.column, op, len(args)))
+		default:
+			args = append(args, value)
+			where = append(where, fmt.Sprintf("%s::text = $%d", f.column, len(args)))
+		}
+	}
+
+	return where, args, nil
+}
+
+// invalidFilter menyebut nama filter di pesan error; errors.Is tetap cocok dengan
+// pagination.ErrInvalidFilter karena code-nya sama
+func invalidFilter(key string) error {
+	return apperror.BadRequest(pagination.ErrInvalidFilter.Code, "filter "+key+" is not supported or has an invalid value")
+}
+
+// parseFilterTime menerima RFC3339 (2024-01-31T10:00:00Z) atau tanggal saja (2024-01-31)
+func parseFilterTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
+
+func joinWhere(where []string) string {
+	if len(where) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(where, " AND ")
+}
+
+// escapeLike supaya karakter % dan _ dari client dicari apa adanya
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
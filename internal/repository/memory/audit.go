@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+type AuditRepositoryImpl struct {
+	store *Store
+}
+
+func NewAuditRepository(store *Store) repository.AuditRepository {
+	return &AuditRepositoryImpl{store: store}
+}
+
+// nullableText dipakai kolom yang disimpan NULLIF($1, ”): NULL tidak cocok dengan filter apa pun
+func nullableText(value func(models.AuditLog) string) listFilter[models.AuditLog] {
+	return listFilter[models.AuditLog]{kind: filterEquals, text: func(entry models.AuditLog) (string, bool) {
+		v := value(entry)
+		return v, v != ""
+	}}
+}
+
+// auditList sama dengan sort dan filter FindAll audit di PostgreSQL; default data terbaru dulu
+var auditList = listSpec[models.AuditLog]{
+	key:         func(a models.AuditLog) uint { return uint(a.ID) },
+	defaultSort: "id",
+	defaultDesc: true,
+	sorts: map[string]sortField[models.AuditLog]{
+		"id":         intSort(func(a models.AuditLog) int64 { return int64(a.ID) }),
+		"created_at": timeSort(func(a models.AuditLog) time.Time { return a.CreatedAt }),
+	},
+	filters: map[string]listFilter[models.AuditLog]{
+		"actor":       nullableText(func(a models.AuditLog) string { return a.ActorEmployeeCode }),
+		"entity_type": textFilter(filterEquals, func(a models.AuditLog) string { return a.EntityType }),
+		"entity_key":  textFilter(filterEquals, func(a models.AuditLog) string { return a.EntityKey }),
+		"action":      textFilter(filterEquals, func(a models.AuditLog) string { return a.Action }),
+		"request_id":  nullableText(func(a models.AuditLog) string { return a.RequestID }),
+		"since":       {kind: filterSince, since: func(a models.AuditLog) time.Time { return a.CreatedAt }},
+		"until":       {kind: filterUntil, since: func(a models.AuditLog) time.Time { return a.CreatedAt }},
+	},
+}
+
+// FindAll implements repository.AuditRepository.
+func (r *AuditRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.AuditLog, pagination.Page, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, pagination.Page{}, err
+	}
+	defer unlock()
+
+	rows, page, err := findList(r.store.auditLogs.filter(nil), auditList, p)
+	for i := range rows {
+		rows[i] = copyAuditLog(rows[i])
+	}
+	return pointers(rows), page, err
+}
+
+// Save implements repository.AuditRepository.
+func (r *AuditRepositoryImpl) Save(ctx context.Context, entry *models.AuditLog) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	saved := r.store.auditLogs.insert(func(id uint) models.AuditLog {
+		row := copyAuditLog(*entry)
+		row.ID, row.CreatedAt = uint64(id), time.Now()
+		return row
+	})
+	entry.ID, entry.CreatedAt = saved.ID, saved.CreatedAt
+	return nil
+}
+
+// copyAuditLog menyalin isi JSON supaya data di Store tidak ikut berubah lewat slice pemanggil
+func copyAuditLog(entry models.AuditLog) models.AuditLog {
+	entry.Before = bytes.Clone(entry.Before)
+	entry.After = bytes.Clone(entry.After)
+	entry.Diff = bytes.Clone(entry.Diff)
+	return entry
+}
@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+// revokedToken adalah baris tabel revoked_token
+type revokedToken struct {
+	JTI       string
+	ExpiresAt time.Time
+}
+
+type AuthRepositoryImpl struct {
+	store *Store
+}
+
+func NewAuthRepository(store *Store) repository.AuthRepository {
+	return &AuthRepositoryImpl{store: store}
+}
+
+// SaveRefreshToken implements repository.AuthRepository.
+func (r *AuthRepositoryImpl) SaveRefreshToken(ctx context.Context, token *models.RefreshToken) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return r.insertRefreshToken(token)
+}
+
+// FindRefreshToken implements repository.AuthRepository.
+func (r *AuthRepositoryImpl) FindRefreshToken(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	_, token, ok := r.store.refreshTokens.find(func(t models.RefreshToken) bool { return t.TokenHash == tokenHash })
+	if !ok {
+		return nil, repository.ErrRefreshTokenNotFound
+	}
+	return &token, nil
+}
+
+// RotateRefreshToken implements repository.AuthRepository.
+func (r *AuthRepositoryImpl) RotateRefreshToken(ctx context.Context, oldID uint, next *models.RefreshToken) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// token lama yang sudah dicabut tidak boleh menghasilkan token baru
+	old, ok := r.store.refreshTokens.get(oldID)
+	if !ok || old.RevokedAt != nil {
+		return repository.ErrRefreshTokenRevoked
+	}
+
+	if err := r.insertRefreshToken(next); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	old.RevokedAt, old.ReplacedBy = &now, &next.ID
+	r.store.refreshTokens.put(old.ID, old)
+	return nil
+}
+
+// RevokeRefreshFamily implements repository.AuthRepository.
+func (r *AuthRepositoryImpl) RevokeRefreshFamily(ctx context.Context, familyID string) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	now := time.Now()
+	for _, token := range r.store.refreshTokens.filter(func(t models.RefreshToken) bool {
+		return t.FamilyID == familyID && t.RevokedAt == nil
+	}) {
+		token.RevokedAt = &now
+		r.store.refreshTokens.put(token.ID, token)
+	}
+	return nil
+}
+
+// RevokeAccessToken implements repository.AuthRepository.
+func (r *AuthRepositoryImpl) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// ON CONFLICT (jti) DO NOTHING
+	if !r.store.revokedTokens.exists(func(t revokedToken) bool { return t.JTI == jti }) {
+		r.store.revokedTokens.insert(func(uint) revokedToken { return revokedToken{JTI: jti, ExpiresAt: expiresAt} })
+	}
+
+	// token yang sudah kedaluwarsa tidak perlu dicatat lagi
+	now := time.Now()
+	r.store.revokedTokens.deleteWhere(func(t revokedToken) bool { return t.ExpiresAt.Before(now) })
+	return nil
+}
+
+// IsAccessTokenRevoked implements repository.AuthRepository.
+func (r *AuthRepositoryImpl) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	return r.store.revokedTokens.exists(func(t revokedToken) bool { return t.JTI == jti }), nil
+}
+
+// insertRefreshToken mengecek token_hash unique dan foreign key employee_code.
+// Harus dipanggil saat lock dipegang.
+func (r *AuthRepositoryImpl) insertRefreshToken(token *models.RefreshToken) error {
+	if !r.store.employees.exists(func(e models.Employee) bool { return e.EmployeeCode == token.EmployeeCode }) {
+		return repository.ErrEmployeeNotFound
+	}
+	if r.store.refreshTokens.exists(func(t models.RefreshToken) bool { return t.TokenHash == token.TokenHash }) {
+		return repository.ErrDuplicate
+	}
+
+	saved := r.store.refreshTokens.insert(func(id uint) models.RefreshToken {
+		row := *token
+		row.ID, row.CreatedAt = id, time.Now()
+		return row
+	})
+	token.ID, token.CreatedAt = saved.ID, saved.CreatedAt
+	return nil
+}
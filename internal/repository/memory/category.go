@@ -0,0 +1,121 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+type CategoryRepositoryImpl struct {
+	store *Store
+}
+
+func NewCategoryRepository(store *Store) repository.CategoryRepository {
+	return &CategoryRepositoryImpl{store: store}
+}
+
+// categoryList sama dengan sort dan filter FindAll category di PostgreSQL
+var categoryList = listSpec[models.Category]{
+	key:         func(c models.Category) uint { return c.ID },
+	defaultSort: "id",
+	sorts: map[string]sortField[models.Category]{
+		"id":   intSort(func(c models.Category) int64 { return int64(c.ID) }),
+		"name": textSort(func(c models.Category) string { return c.Name }),
+	},
+	filters: map[string]listFilter[models.Category]{
+		"name": textFilter(filterContains, func(c models.Category) string { return c.Name }),
+	},
+}
+
+// FindAll implements repository.CategoryRepository.
+func (r *CategoryRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.Category, pagination.Page, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, pagination.Page{}, err
+	}
+	defer unlock()
+
+	rows, page, err := findList(r.store.categories.filter(nil), categoryList, p)
+	return pointers(rows), page, err
+}
+
+// FindById implements repository.CategoryRepository.
+func (r *CategoryRepositoryImpl) FindById(ctx context.Context, id int) (*models.Category, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	category, ok := r.store.categories.get(uint(id))
+	if !ok {
+		return nil, repository.ErrCategoryNotFound
+	}
+	return &category, nil
+}
+
+// Save implements repository.CategoryRepository.
+func (r *CategoryRepositoryImpl) Save(ctx context.Context, category *models.Category) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	*category = r.store.categories.insert(func(id uint) models.Category {
+		return models.Category{ID: id, Name: category.Name}
+	})
+	return nil
+}
+
+// Update implements repository.CategoryRepository.
+func (r *CategoryRepositoryImpl) Update(ctx context.Context, category *models.Category) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	existing, ok := r.store.categories.get(category.ID)
+	if !ok {
+		return repository.ErrCategoryNotFound
+	}
+
+	existing.Name = category.Name
+	r.store.categories.put(existing.ID, existing)
+	return nil
+}
+
+// Delete implements repository.CategoryRepository.
+func (r *CategoryRepositoryImpl) Delete(ctx context.Context, id int) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, ok := r.store.categories.get(uint(id)); !ok {
+		return repository.ErrCategoryNotFound
+	}
+	// product.id_category ON DELETE RESTRICT
+	if r.store.products.exists(func(p models.Product) bool { return p.IDCategory == uint(id) }) {
+		return repository.ErrStillReferenced
+	}
+
+	r.store.categories.delete(uint(id))
+	return nil
+}
+
+// pointers mengubah hasil list menjadi slice pointer seperti hasil repository PostgreSQL
+func pointers[T any](rows []T) []*T {
+	if rows == nil {
+		return nil
+	}
+	result := make([]*T, len(rows))
+	for i := range rows {
+		result[i] = &rows[i]
+	}
+	return result
+}
@@ -0,0 +1,180 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+type EmployeeRepositoryImpl struct {
+	store *Store
+}
+
+func NewEmployeeRepository(store *Store) repository.EmployeeRepository {
+	return &EmployeeRepositoryImpl{store: store}
+}
+
+// employeeList sama dengan sort dan filter FindAll employee di PostgreSQL
+var employeeList = listSpec[models.Employee]{
+	key:         func(e models.Employee) uint { return e.ID },
+	defaultSort: "id",
+	sorts: map[string]sortField[models.Employee]{
+		"id":   intSort(func(e models.Employee) int64 { return int64(e.ID) }),
+		"name": textSort(func(e models.Employee) string { return e.EmployeeName }),
+		"code": textSort(func(e models.Employee) string { return e.EmployeeCode }),
+	},
+	filters: map[string]listFilter[models.Employee]{
+		"name":           textFilter(filterContains, func(e models.Employee) string { return e.EmployeeName }),
+		"warehouse_code": textFilter(filterEquals, func(e models.Employee) string { return e.WarehouseCode }),
+		"id_role":        {kind: filterInt, int: func(e models.Employee) int64 { return int64(e.IDRole) }},
+	},
+}
+
+// FindAll implements repository.EmployeeRepository.
+func (r *EmployeeRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.Employee, pagination.Page, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, pagination.Page{}, err
+	}
+	defer unlock()
+
+	rows, page, err := findList(r.store.employees.filter(nil), employeeList, p)
+	for i := range rows {
+		// query list PostgreSQL tidak membaca id dan password
+		rows[i].ID = 0
+		rows[i].Password = ""
+	}
+	return pointers(rows), page, err
+}
+
+// FindById implements repository.EmployeeRepository.
+func (r *EmployeeRepositoryImpl) FindById(ctx context.Context, employee_code string) (*models.Employee, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	_, emp, ok := r.store.employees.find(func(e models.Employee) bool { return e.EmployeeCode == employee_code })
+	if !ok {
+		return nil, repository.ErrEmployeeNotFound
+	}
+	emp.ID = 0
+	return &emp, nil
+}
+
+// FindByLogin implements repository.EmployeeRepository.
+func (r *EmployeeRepositoryImpl) FindByLogin(ctx context.Context, login string) (*models.Employee, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	_, emp, ok := r.store.employees.find(func(e models.Employee) bool {
+		return e.UserID == login || e.EmployeeCode == login
+	})
+	if !ok {
+		return nil, repository.ErrEmployeeNotFound
+	}
+	role, ok := r.store.roles.get(emp.IDRole)
+	if !ok {
+		return nil, repository.ErrEmployeeNotFound
+	}
+
+	emp.ID = 0
+	emp.Role = models.Role{ID: role.ID, RoleName: role.RoleName}
+	return &emp, nil
+}
+
+// Save implements repository.EmployeeRepository.
+func (r *EmployeeRepositoryImpl) Save(ctx context.Context, employee *models.Employee) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if r.store.employees.exists(func(e models.Employee) bool {
+		return e.UserID == employee.UserID || e.EmployeeCode == employee.EmployeeCode
+	}) {
+		return repository.ErrDuplicate
+	}
+	if err := r.checkReferences(employee); err != nil {
+		return err
+	}
+
+	saved := r.store.employees.insert(func(id uint) models.Employee {
+		return models.Employee{
+			ID:            id,
+			UserID:        employee.UserID,
+			EmployeeName:  employee.EmployeeName,
+			Password:      employee.Password,
+			EmployeeCode:  employee.EmployeeCode,
+			IDRole:        employee.IDRole,
+			WarehouseCode: employee.WarehouseCode,
+		}
+	})
+	employee.ID = saved.ID
+	return nil
+}
+
+// Update implements repository.EmployeeRepository.
+func (r *EmployeeRepositoryImpl) Update(ctx context.Context, employee *models.Employee) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	id, emp, ok := r.store.employees.find(func(e models.Employee) bool { return e.EmployeeCode == employee.EmployeeCode })
+	if !ok {
+		return repository.ErrEmployeeNotFound
+	}
+	if err := r.checkReferences(employee); err != nil {
+		return err
+	}
+
+	emp.EmployeeName = employee.EmployeeName
+	emp.Password = employee.Password
+	emp.IDRole = employee.IDRole
+	emp.WarehouseCode = employee.WarehouseCode
+	r.store.employees.put(id, emp)
+	return nil
+}
+
+// Delete implements repository.EmployeeRepository.
+func (r *EmployeeRepositoryImpl) Delete(ctx context.Context, id string) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	key, _, ok := r.store.employees.find(func(e models.Employee) bool { return e.EmployeeCode == id })
+	if !ok {
+		return repository.ErrEmployeeNotFound
+	}
+	// transactions.employee_code ON DELETE RESTRICT
+	if r.store.transactions.exists(func(t models.Transaction) bool { return t.EmployeeCode == id }) {
+		return repository.ErrStillReferenced
+	}
+
+	r.store.employees.delete(key)
+	// refresh_token.employee_code ON DELETE CASCADE
+	r.store.refreshTokens.deleteWhere(func(t models.RefreshToken) bool { return t.EmployeeCode == id })
+	return nil
+}
+
+// checkReferences mengecek foreign key id_role dan warehouse_code
+func (r *EmployeeRepositoryImpl) checkReferences(employee *models.Employee) error {
+	if _, ok := r.store.roles.get(employee.IDRole); !ok {
+		return repository.ErrInvalidReference
+	}
+	if !r.store.warehouses.exists(func(w models.Warehouse) bool { return w.WarehouseCode == employee.WarehouseCode }) {
+		return repository.ErrInvalidReference
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+type InventoryRepositoryImpl struct {
+	store *Store
+}
+
+func NewInventoryRepository(store *Store) repository.InventoryRepository {
+	return &InventoryRepositoryImpl{store: store}
+}
+
+// FindAllByWarehouse implements repository.InventoryRepository.
+func (r *InventoryRepositoryImpl) FindAllByWarehouse(ctx context.Context, codeWarehouse string) ([]*models.Inventory, error) {
+	return r.findAll(ctx, func(i models.Inventory) bool { return i.CodeWarehouse == codeWarehouse },
+		func(a, b models.Inventory) int {
+			return cmp.Or(strings.Compare(a.Product.ProductName, b.Product.ProductName), cmp.Compare(a.IDSize, b.IDSize))
+		})
+}
+
+// FindAllByProduct implements repository.InventoryRepository.
+func (r *InventoryRepositoryImpl) FindAllByProduct(ctx context.Context, codeProduct string) ([]*models.Inventory, error) {
+	return r.findAll(ctx, func(i models.Inventory) bool { return i.CodeProduct == codeProduct },
+		func(a, b models.Inventory) int {
+			return cmp.Or(strings.Compare(a.CodeWarehouse, b.CodeWarehouse), cmp.Compare(a.IDSize, b.IDSize))
+		})
+}
+
+// FindAllByVariant implements repository.InventoryRepository.
+func (r *InventoryRepositoryImpl) FindAllByVariant(ctx context.Context, codeProduct string, idSize int) ([]*models.Inventory, error) {
+	return r.findAll(ctx, func(i models.Inventory) bool { return i.CodeProduct == codeProduct && i.IDSize == uint(idSize) },
+		func(a, b models.Inventory) int { return strings.Compare(a.CodeWarehouse, b.CodeWarehouse) })
+}
+
+// Adjust implements repository.InventoryRepository.
+func (r *InventoryRepositoryImpl) Adjust(ctx context.Context, codeProduct string, idSize int, codeWarehouse string, delta int) (*models.Inventory, error) {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	inv, err := r.store.adjustInventory(codeProduct, uint(idSize), codeWarehouse, delta)
+	if err != nil {
+		return nil, err
+	}
+	inv = r.store.withInventoryRelations(inv)
+	return &inv, nil
+}
+
+func (r *InventoryRepositoryImpl) findAll(ctx context.Context, match func(models.Inventory) bool, compare func(a, b models.Inventory) int) ([]*models.Inventory, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	inventories := r.store.inventories.filter(match)
+	for i := range inventories {
+		inventories[i] = r.store.withInventoryRelations(inventories[i])
+	}
+	slices.SortStableFunc(inventories, compare)
+	return pointers(inventories), nil
+}
+
+// adjustInventory sama dengan adjustInventory di package repository: baris stok baru
+// hanya dibuat untuk delta positif dan quantity tidak boleh menjadi negatif
+func (s *Store) adjustInventory(codeProduct string, idSize uint, codeWarehouse string, delta int) (models.Inventory, error) {
+	id, inv, ok := s.inventories.find(func(i models.Inventory) bool {
+		return i.CodeProduct == codeProduct && i.IDSize == idSize && i.CodeWarehouse == codeWarehouse
+	})
+
+	if !ok {
+		if delta <= 0 {
+			return inv, repository.ErrInsufficientStock
+		}
+		// foreign key dicek dengan urutan yang sama seperti constraint di PostgreSQL
+		if _, _, ok := s.productByCode(codeProduct); !ok {
+			return inv, invalidReference(repository.ErrProductNotFound)
+		}
+		if !s.warehouses.exists(func(w models.Warehouse) bool { return w.WarehouseCode == codeWarehouse }) {
+			return inv, invalidReference(repository.ErrWarehouseNotFound)
+		}
+		if _, ok := s.sizes.get(idSize); !ok {
+			return inv, invalidReference(repository.ErrSizeNotFound)
+		}
+
+		inv = s.inventories.insert(func(id uint) models.Inventory {
+			return models.Inventory{ID: id, CodeProduct: codeProduct, IDSize: idSize, CodeWarehouse: codeWarehouse}
+		})
+		id = inv.ID
+	}
+
+	if inv.Quantity+delta < 0 {
+		return inv, repository.ErrInsufficientStock
+	}
+
+	inv.Quantity += delta
+	s.inventories.put(id, inv)
+	return inv, nil
+}
+
+// applyMovements menjalankan perubahan stok dengan urutan yang sama seperti applyMovements
+// di package repository. Pemanggil harus memakai savepoint supaya bisa rollback.
+func (s *Store) applyMovements(movements []repository.InventoryMovement) error {
+	sorted := slices.Clone(movements)
+	slices.SortStableFunc(sorted, func(a, b repository.InventoryMovement) int {
+		return cmp.Or(
+			strings.Compare(a.CodeWarehouse, b.CodeWarehouse),
+			strings.Compare(a.CodeProduct, b.CodeProduct),
+			cmp.Compare(a.IDSize, b.IDSize),
+		)
+	})
+
+	for _, m := range sorted {
+		if _, err := s.adjustInventory(m.CodeProduct, m.IDSize, m.CodeWarehouse, m.Delta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withInventoryRelations mengisi relasi seperti JOIN pada inventorySelect
+func (s *Store) withInventoryRelations(inv models.Inventory) models.Inventory {
+	_, product, _ := s.productByCode(inv.CodeProduct)
+	size, _ := s.sizes.get(inv.IDSize)
+	_, warehouse, _ := s.warehouses.find(func(w models.Warehouse) bool { return w.WarehouseCode == inv.CodeWarehouse })
+
+	inv.Product = models.Product{ProductName: product.ProductName, ProductCode: inv.CodeProduct}
+	inv.Size = models.Size{ID: inv.IDSize, Name: size.Name}
+	inv.Warehouse = models.Warehouse{WarehouseName: warehouse.WarehouseName}
+	return inv
+}
@@ -0,0 +1,249 @@
+package memory
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+)
+
+type filterKind int
+
+// jenis filter sama dengan listSpec di package repository
+const (
+	filterEquals filterKind = iota
+	filterContains
+	filterInt
+	filterSince
+	filterUntil
+)
+
+// listFilter membaca nilai kolom yang difilter; ok false berarti kolom bernilai NULL
+type listFilter[T any] struct {
+	kind  filterKind
+	text  func(T) (string, bool)
+	int   func(T) int64
+	since func(T) time.Time
+}
+
+type sortKind int
+
+const (
+	sortText sortKind = iota
+	sortInt
+	sortTime
+)
+
+// sortField adalah ekspresi sort; value mengembalikan string, int64 atau time.Time sesuai kind
+type sortField[T any] struct {
+	kind  sortKind
+	value func(T) any
+}
+
+func textSort[T any](value func(T) string) sortField[T] {
+	return sortField[T]{kind: sortText, value: func(row T) any { return value(row) }}
+}
+
+func intSort[T any](value func(T) int64) sortField[T] {
+	return sortField[T]{kind: sortInt, value: func(row T) any { return value(row) }}
+}
+
+func timeSort[T any](value func(T) time.Time) sortField[T] {
+	return sortField[T]{kind: sortTime, value: func(row T) any { return value(row) }}
+}
+
+func textFilter[T any](kind filterKind, value func(T) string) listFilter[T] {
+	return listFilter[T]{kind: kind, text: func(row T) (string, bool) { return value(row), true }}
+}
+
+// listSpec adalah padanan in-memory listSpec di package repository
+type listSpec[T any] struct {
+	key         func(T) uint
+	sorts       map[string]sortField[T]
+	defaultSort string
+	defaultDesc bool
+	filters     map[string]listFilter[T]
+}
+
+// findList menerapkan filter, sort dan pagination (offset atau cursor) pada rows
+// dengan aturan dan error yang sama seperti findList di package repository
+func findList[T any](rows []T, spec listSpec[T], p pagination.Params) ([]T, pagination.Page, error) {
+	page := pagination.Page{Limit: p.Limit, Page: p.Page}
+
+	sortName, desc := p.Sort, p.Desc
+	if sortName == "" {
+		sortName, desc = spec.defaultSort, spec.defaultDesc
+	}
+	field, ok := spec.sorts[sortName]
+	if !ok {
+		return nil, page, pagination.ErrInvalidSort
+	}
+
+	match, err := spec.match(p.Filters)
+	if err != nil {
+		return nil, page, err
+	}
+	rows = slices.DeleteFunc(slices.Clone(rows), func(row T) bool { return !match(row) })
+	page.Total = int64(len(rows))
+
+	compare := func(a, b T) int {
+		c := compareSortValue(field.kind, field.value(a), field.value(b))
+		if c == 0 {
+			c = cmp.Compare(spec.key(a), spec.key(b))
+		}
+		if desc {
+			return -c
+		}
+		return c
+	}
+	slices.SortFunc(rows, compare)
+
+	if p.Cursor != nil {
+		if p.Cursor.Sort != sortName || p.Cursor.Desc != desc {
+			return nil, page, pagination.ErrInvalidCursor
+		}
+		key, err := strconv.ParseUint(p.Cursor.Key, 10, 64)
+		if err != nil {
+			return nil, page, pagination.ErrInvalidCursor
+		}
+		value, err := parseSortValue(field.kind, p.Cursor.Value)
+		if err != nil {
+			return nil, page, pagination.ErrInvalidCursor
+		}
+
+		// lewati baris sampai posisi cursor (nilai sort, key)
+		rows = slices.DeleteFunc(rows, func(row T) bool {
+			c := compareSortValue(field.kind, field.value(row), value)
+			if c == 0 {
+				c = cmp.Compare(uint64(spec.key(row)), key)
+			}
+			if desc {
+				c = -c
+			}
+			return c <= 0
+		})
+	}
+
+	offset := min(p.Offset(), len(rows))
+	rows = rows[offset:]
+
+	if len(rows) > p.Limit {
+		last := rows[p.Limit-1]
+		page.NextCursor = pagination.EncodeCursor(pagination.Cursor{
+			Sort:  sortName,
+			Desc:  desc,
+			Value: formatSortValue(field.kind, field.value(last)),
+			Key:   strconv.FormatUint(uint64(spec.key(last)), 10),
+		})
+		rows = rows[:p.Limit]
+	}
+
+	return rows, page, nil
+}
+
+// match menyusun predikat dari filter client; filter yang tidak dikenal ditolak
+func (s listSpec[T]) match(filters map[string]string) (func(T) bool, error) {
+	var preds []func(T) bool
+
+	// urutan tetap supaya filter tidak dikenal yang dilaporkan selalu sama
+	keys := slices.Sorted(maps.Keys(filters))
+	for _, key := range keys {
+		value := filters[key]
+		f, ok := s.filters[key]
+		if !ok {
+			return nil, invalidFilter(key)
+		}
+
+		switch f.kind {
+		case filterContains:
+			needle := strings.ToLower(value)
+			preds = append(preds, func(row T) bool {
+				text, ok := f.text(row)
+				return ok && strings.Contains(strings.ToLower(text), needle)
+			})
+		case filterInt:
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, invalidFilter(key)
+			}
+			preds = append(preds, func(row T) bool { return f.int(row) == int64(n) })
+		case filterSince, filterUntil:
+			t, err := parseFilterTime(value)
+			if err != nil {
+				return nil, invalidFilter(key)
+			}
+			until := f.kind == filterUntil
+			preds = append(preds, func(row T) bool {
+				if until {
+					return f.since(row).Before(t)
+				}
+				return !f.since(row).Before(t)
+			})
+		default:
+			preds = append(preds, func(row T) bool {
+				text, ok := f.text(row)
+				return ok && text == value
+			})
+		}
+	}
+
+	return func(row T) bool {
+		for _, pred := range preds {
+			if !pred(row) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func compareSortValue(kind sortKind, a, b any) int {
+	switch kind {
+	case sortInt:
+		return cmp.Compare(a.(int64), b.(int64))
+	case sortTime:
+		return a.(time.Time).Compare(b.(time.Time))
+	default:
+		return strings.Compare(a.(string), b.(string))
+	}
+}
+
+func formatSortValue(kind sortKind, value any) string {
+	switch kind {
+	case sortInt:
+		return strconv.FormatInt(value.(int64), 10)
+	case sortTime:
+		return value.(time.Time).Format(time.RFC3339Nano)
+	default:
+		return value.(string)
+	}
+}
+
+func parseSortValue(kind sortKind, text string) (any, error) {
+	switch kind {
+	case sortInt:
+		return strconv.ParseInt(text, 10, 64)
+	case sortTime:
+		return time.Parse(time.RFC3339Nano, text)
+	default:
+		return text, nil
+	}
+}
+
+// invalidFilter sama dengan error filter di package repository
+func invalidFilter(key string) error {
+	return apperror.BadRequest(pagination.ErrInvalidFilter.Code, "filter "+key+" is not supported or has an invalid value")
+}
+
+// parseFilterTime menerima RFC3339 (2024-01-31T10:00:00Z) atau tanggal saja (2024-01-31)
+func parseFilterTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
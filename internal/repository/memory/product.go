@@ -0,0 +1,181 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+type ProductRepositoryImpl struct {
+	store *Store
+}
+
+func NewProductRepository(store *Store) repository.ProductRepository {
+	return &ProductRepositoryImpl{store: store}
+}
+
+// productList sama dengan sort dan filter FindAll product di PostgreSQL
+var productList = listSpec[models.Product]{
+	key:         func(p models.Product) uint { return p.ID },
+	defaultSort: "id",
+	sorts: map[string]sortField[models.Product]{
+		"id":    intSort(func(p models.Product) int64 { return int64(p.ID) }),
+		"name":  textSort(func(p models.Product) string { return p.ProductName }),
+		"code":  textSort(func(p models.Product) string { return p.ProductCode }),
+		"price": intSort(func(p models.Product) int64 { return int64(p.Price) }),
+	},
+	filters: map[string]listFilter[models.Product]{
+		"name":        textFilter(filterContains, func(p models.Product) string { return p.ProductName }),
+		"code":        textFilter(filterEquals, func(p models.Product) string { return p.ProductCode }),
+		"id_category": {kind: filterInt, int: func(p models.Product) int64 { return int64(p.IDCategory) }},
+		"category":    textFilter(filterContains, func(p models.Product) string { return p.Category.Name }),
+	},
+}
+
+// FindAll implements repository.ProductRepository.
+func (r *ProductRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.Product, pagination.Page, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, pagination.Page{}, err
+	}
+	defer unlock()
+
+	products := r.store.products.filter(nil)
+	for i := range products {
+		products[i] = r.store.withCategory(products[i])
+	}
+
+	rows, page, err := findList(products, productList, p)
+	return pointers(rows), page, err
+}
+
+// FindById implements repository.ProductRepository.
+func (r *ProductRepositoryImpl) FindById(ctx context.Context, id int) (*models.Product, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	product, ok := r.store.products.get(uint(id))
+	if !ok {
+		return nil, repository.ErrProductNotFound
+	}
+	product = r.store.withCategory(product)
+	return &product, nil
+}
+
+// FindByCode implements repository.ProductRepository.
+func (r *ProductRepositoryImpl) FindByCode(ctx context.Context, code string) (*models.Product, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	_, product, ok := r.store.productByCode(code)
+	if !ok {
+		return nil, repository.ErrProductNotFound
+	}
+	product = r.store.withCategory(product)
+	return &product, nil
+}
+
+// Save implements repository.ProductRepository.
+func (r *ProductRepositoryImpl) Save(ctx context.Context, product *models.Product) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, _, ok := r.store.productByCode(product.ProductCode); ok {
+		return repository.ErrDuplicate
+	}
+	if _, ok := r.store.categories.get(product.IDCategory); !ok {
+		return invalidReference(repository.ErrCategoryNotFound)
+	}
+
+	saved := r.store.products.insert(func(id uint) models.Product {
+		return models.Product{
+			ID:                 id,
+			ProductName:        product.ProductName,
+			Price:              product.Price,
+			DescriptionProduct: product.DescriptionProduct,
+			ProductCode:        product.ProductCode,
+			IDCategory:         product.IDCategory,
+		}
+	})
+	product.ID = saved.ID
+	return nil
+}
+
+// Update implements repository.ProductRepository.
+func (r *ProductRepositoryImpl) Update(ctx context.Context, product *models.Product) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	existing, ok := r.store.products.get(product.ID)
+	if !ok {
+		return repository.ErrProductNotFound
+	}
+	if _, ok := r.store.categories.get(product.IDCategory); !ok {
+		return invalidReference(repository.ErrCategoryNotFound)
+	}
+
+	existing.ProductName = product.ProductName
+	existing.Price = product.Price
+	existing.DescriptionProduct = product.DescriptionProduct
+	existing.IDCategory = product.IDCategory
+	r.store.products.put(existing.ID, existing)
+	return nil
+}
+
+// Delete implements repository.ProductRepository.
+func (r *ProductRepositoryImpl) Delete(ctx context.Context, id int) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	product, ok := r.store.products.get(uint(id))
+	if !ok {
+		return repository.ErrProductNotFound
+	}
+
+	// product_detail dan inventory ikut terhapus (ON DELETE CASCADE), tetapi variant
+	// yang sudah dipakai detail_transactions menahan penghapusan (ON DELETE RESTRICT)
+	ofProduct := func(d models.ProductDetail) bool { return d.CodeProduct == product.ProductCode }
+	for _, detail := range r.store.productDetails.filter(ofProduct) {
+		if r.store.detailReferenced(detail.ID) {
+			return repository.ErrStillReferenced
+		}
+	}
+
+	r.store.productDetails.deleteWhere(ofProduct)
+	r.store.inventories.deleteWhere(func(i models.Inventory) bool { return i.CodeProduct == product.ProductCode })
+	r.store.products.delete(product.ID)
+	return nil
+}
+
+func (s *Store) productByCode(code string) (uint, models.Product, bool) {
+	return s.products.find(func(p models.Product) bool { return p.ProductCode == code })
+}
+
+// withCategory mengisi relasi Category seperti JOIN category pada query product
+func (s *Store) withCategory(product models.Product) models.Product {
+	category, _ := s.categories.get(product.IDCategory)
+	product.Category = models.Category{ID: product.IDCategory, Name: category.Name}
+	return product
+}
+
+// detailReferenced mengecek apakah variant masih dipakai detail_transactions
+func (s *Store) detailReferenced(idDetailProduct uint) bool {
+	return s.details.exists(func(d models.DetailTransaction) bool { return d.IDDetailProduct == idDetailProduct })
+}
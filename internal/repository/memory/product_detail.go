@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+type ProductDetailRepositoryImpl struct {
+	store *Store
+}
+
+func NewProductDetailRepository(store *Store) repository.ProductDetailRepository {
+	return &ProductDetailRepositoryImpl{store: store}
+}
+
+// FindAllByProduct implements repository.ProductDetailRepository.
+func (r *ProductDetailRepositoryImpl) FindAllByProduct(ctx context.Context, codeProduct string) ([]*models.ProductDetail, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	details := r.store.productDetails.filter(func(d models.ProductDetail) bool { return d.CodeProduct == codeProduct })
+	slices.SortStableFunc(details, func(a, b models.ProductDetail) int { return cmp.Compare(a.IDSize, b.IDSize) })
+	for i := range details {
+		details[i] = r.store.withProductAndSize(details[i])
+	}
+	return pointers(details), nil
+}
+
+// FindById implements repository.ProductDetailRepository.
+func (r *ProductDetailRepositoryImpl) FindById(ctx context.Context, id int) (*models.ProductDetail, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	detail, ok := r.store.productDetails.get(uint(id))
+	if !ok {
+		return nil, repository.ErrProductDetailNotFound
+	}
+	detail = r.store.withProductAndSize(detail)
+	return &detail, nil
+}
+
+// FindByBarcode implements repository.ProductDetailRepository.
+func (r *ProductDetailRepositoryImpl) FindByBarcode(ctx context.Context, barcode string) (*models.ProductDetail, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	_, detail, ok := r.store.productDetails.find(func(d models.ProductDetail) bool { return d.Barcode == barcode })
+	if !ok {
+		return nil, repository.ErrProductDetailNotFound
+	}
+	detail = r.store.withProductAndSize(detail)
+	return &detail, nil
+}
+
+// ExistsByProductAndSize implements repository.ProductDetailRepository.
+func (r *ProductDetailRepositoryImpl) ExistsByProductAndSize(ctx context.Context, codeProduct string, idSize int) (bool, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	return r.store.productDetails.exists(func(d models.ProductDetail) bool {
+		return d.CodeProduct == codeProduct && d.IDSize == uint(idSize)
+	}), nil
+}
+
+// Save implements repository.ProductDetailRepository.
+func (r *ProductDetailRepositoryImpl) Save(ctx context.Context, detail *models.ProductDetail) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// urutan pengecekan sama dengan constraint yang dilanggar lebih dulu di PostgreSQL
+	switch {
+	case r.store.productDetails.exists(func(d models.ProductDetail) bool { return d.Barcode == detail.Barcode }):
+		return repository.ErrBarcodeExists
+	case r.store.productDetails.exists(func(d models.ProductDetail) bool {
+		return d.CodeProduct == detail.CodeProduct && d.IDSize == detail.IDSize
+	}):
+		return repository.ErrProductDetailExists
+	}
+	if _, ok := r.store.sizes.get(detail.IDSize); !ok {
+		return invalidReference(repository.ErrSizeNotFound)
+	}
+	if _, _, ok := r.store.productByCode(detail.CodeProduct); !ok {
+		return repository.ErrProductNotFound
+	}
+
+	saved := r.store.productDetails.insert(func(id uint) models.ProductDetail {
+		return models.ProductDetail{ID: id, CodeProduct: detail.CodeProduct, IDSize: detail.IDSize, Barcode: detail.Barcode}
+	})
+	detail.ID = saved.ID
+	return nil
+}
+
+// Delete implements repository.ProductDetailRepository.
+func (r *ProductDetailRepositoryImpl) Delete(ctx context.Context, id int) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, ok := r.store.productDetails.get(uint(id)); !ok {
+		return repository.ErrProductDetailNotFound
+	}
+	// detail_transactions.id_detail_product ON DELETE RESTRICT
+	if r.store.detailReferenced(uint(id)) {
+		return repository.ErrStillReferenced
+	}
+
+	r.store.productDetails.delete(uint(id))
+	return nil
+}
+
+// withProductAndSize mengisi relasi Product (beserta Category) dan Size seperti productDetailSelect
+func (s *Store) withProductAndSize(detail models.ProductDetail) models.ProductDetail {
+	_, product, _ := s.productByCode(detail.CodeProduct)
+	size, _ := s.sizes.get(detail.IDSize)
+
+	detail.Product = s.withCategory(product)
+	detail.Size = models.Size{ID: detail.IDSize, Name: size.Name}
+	return detail
+}
@@ -0,0 +1,172 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+type RoleRepositoryImpl struct {
+	store *Store
+}
+
+func NewRoleRepository(store *Store) repository.RoleRepository {
+	return &RoleRepositoryImpl{store: store}
+}
+
+// roleList sama dengan sort dan filter FindAll role di PostgreSQL
+var roleList = listSpec[models.Role]{
+	key:         func(r models.Role) uint { return r.ID },
+	defaultSort: "id",
+	sorts: map[string]sortField[models.Role]{
+		"id":   intSort(func(r models.Role) int64 { return int64(r.ID) }),
+		"name": textSort(func(r models.Role) string { return r.RoleName }),
+	},
+	filters: map[string]listFilter[models.Role]{
+		"name": textFilter(filterContains, func(r models.Role) string { return r.RoleName }),
+	},
+}
+
+// FindAll implements repository.RoleRepository.
+func (r *RoleRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.Role, pagination.Page, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, pagination.Page{}, err
+	}
+	defer unlock()
+
+	rows, page, err := findList(r.store.roles.filter(nil), roleList, p)
+	for i := range rows {
+		rows[i] = copyRole(rows[i])
+	}
+	return pointers(rows), page, err
+}
+
+// FindById implements repository.RoleRepository.
+func (r *RoleRepositoryImpl) FindById(ctx context.Context, id int) (*models.Role, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	role, ok := r.store.roles.get(uint(id))
+	if !ok {
+		return nil, repository.ErrRoleNotFound
+	}
+	role = copyRole(role)
+	return &role, nil
+}
+
+// Save implements repository.RoleRepository.
+func (r *RoleRepositoryImpl) Save(ctx context.Context, role *models.Role) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if r.store.roles.exists(func(existing models.Role) bool { return existing.RoleName == role.RoleName }) {
+		return repository.ErrRoleNameExists
+	}
+
+	saved := r.store.roles.insert(func(id uint) models.Role {
+		return models.Role{ID: id, RoleName: role.RoleName, Permissions: sortedPermissions(role.Permissions)}
+	})
+	role.ID = saved.ID
+	return nil
+}
+
+// Update implements repository.RoleRepository.
+func (r *RoleRepositoryImpl) Update(ctx context.Context, role *models.Role) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	existing, ok := r.store.roles.get(role.ID)
+	if !ok {
+		return repository.ErrRoleNotFound
+	}
+	if r.store.roles.exists(func(other models.Role) bool { return other.ID != role.ID && other.RoleName == role.RoleName }) {
+		return repository.ErrRoleNameExists
+	}
+
+	existing.RoleName = role.RoleName
+	r.store.roles.put(existing.ID, existing)
+	return nil
+}
+
+// SetPermissions implements repository.RoleRepository.
+func (r *RoleRepositoryImpl) SetPermissions(ctx context.Context, id int, permissions []string) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	existing, ok := r.store.roles.get(uint(id))
+	if !ok {
+		return repository.ErrRoleNotFound
+	}
+
+	existing.Permissions = sortedPermissions(permissions)
+	r.store.roles.put(existing.ID, existing)
+	return nil
+}
+
+// Delete implements repository.RoleRepository.
+func (r *RoleRepositoryImpl) Delete(ctx context.Context, id int) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, ok := r.store.roles.get(uint(id)); !ok {
+		return repository.ErrRoleNotFound
+	}
+	// employee.id_role ON DELETE RESTRICT
+	if r.store.employees.exists(func(e models.Employee) bool { return e.IDRole == uint(id) }) {
+		return repository.ErrRoleInUse
+	}
+
+	r.store.roles.delete(uint(id))
+	return nil
+}
+
+// FindAllPermissions implements repository.RoleRepository.
+func (r *RoleRepositoryImpl) FindAllPermissions(ctx context.Context) (map[uint][]string, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	permissions := make(map[uint][]string)
+	for _, role := range r.store.roles.filter(nil) {
+		if len(role.Permissions) > 0 {
+			permissions[role.ID] = slices.Clone(role.Permissions)
+		}
+	}
+	return permissions, nil
+}
+
+// sortedPermissions menyalin permission tanpa duplikat dan urut nama, sama seperti
+// primary key (id_role, permission) dan ORDER BY permission di PostgreSQL
+func sortedPermissions(permissions []string) []string {
+	return slices.Compact(slices.Sorted(slices.Values(permissions)))
+}
+
+// copyRole menyalin slice permission supaya pemanggil tidak mengubah isi Store
+func copyRole(role models.Role) models.Role {
+	role.Permissions = slices.Clone(role.Permissions)
+	if role.Permissions == nil {
+		role.Permissions = []string{}
+	}
+	return role
+}
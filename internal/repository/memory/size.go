@@ -0,0 +1,110 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+type SizeRepositoryImpl struct {
+	store *Store
+}
+
+func NewSizeRepository(store *Store) repository.SizeRepository {
+	return &SizeRepositoryImpl{store: store}
+}
+
+// sizeList sama dengan sort dan filter FindAll size di PostgreSQL
+var sizeList = listSpec[models.Size]{
+	key:         func(s models.Size) uint { return s.ID },
+	defaultSort: "id",
+	sorts: map[string]sortField[models.Size]{
+		"id":   intSort(func(s models.Size) int64 { return int64(s.ID) }),
+		"name": textSort(func(s models.Size) string { return s.Name }),
+	},
+	filters: map[string]listFilter[models.Size]{
+		"name": textFilter(filterContains, func(s models.Size) string { return s.Name }),
+	},
+}
+
+// FindAll implements repository.SizeRepository.
+func (r *SizeRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.Size, pagination.Page, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, pagination.Page{}, err
+	}
+	defer unlock()
+
+	rows, page, err := findList(r.store.sizes.filter(nil), sizeList, p)
+	return pointers(rows), page, err
+}
+
+// FindById implements repository.SizeRepository.
+func (r *SizeRepositoryImpl) FindById(ctx context.Context, id int) (*models.Size, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	size, ok := r.store.sizes.get(uint(id))
+	if !ok {
+		return nil, repository.ErrSizeNotFound
+	}
+	return &size, nil
+}
+
+// Save implements repository.SizeRepository.
+func (r *SizeRepositoryImpl) Save(ctx context.Context, size *models.Size) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	*size = r.store.sizes.insert(func(id uint) models.Size {
+		return models.Size{ID: id, Name: size.Name}
+	})
+	return nil
+}
+
+// Update implements repository.SizeRepository.
+func (r *SizeRepositoryImpl) Update(ctx context.Context, size *models.Size) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	existing, ok := r.store.sizes.get(size.ID)
+	if !ok {
+		return repository.ErrSizeNotFound
+	}
+
+	existing.Name = size.Name
+	r.store.sizes.put(existing.ID, existing)
+	return nil
+}
+
+// Delete implements repository.SizeRepository.
+func (r *SizeRepositoryImpl) Delete(ctx context.Context, id int) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, ok := r.store.sizes.get(uint(id)); !ok {
+		return repository.ErrSizeNotFound
+	}
+	// product_detail.id_size dan inventory.id_size ON DELETE RESTRICT
+	if r.store.productDetails.exists(func(d models.ProductDetail) bool { return d.IDSize == uint(id) }) ||
+		r.store.inventories.exists(func(i models.Inventory) bool { return i.IDSize == uint(id) }) {
+		return repository.ErrStillReferenced
+	}
+
+	r.store.sizes.delete(uint(id))
+	return nil
+}
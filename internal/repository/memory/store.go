@@ -0,0 +1,240 @@
+// Package memory berisi implementasi repository.* yang menyimpan data di memori.
+// Dipakai untuk test service dan handler (httptest) tanpa database.
+//
+// Semua repository yang dibuat dari Store yang sama berbagi data dan satu lock,
+// sehingga aturan unique, foreign key (restrict/cascade) dan error domain-nya
+// sama dengan implementasi PostgreSQL di package repository:
+//
+//	store := memory.NewStore()
+//	warehouses := memory.NewWarehouseRepository(store)
+//	employees := memory.NewEmployeeRepository(store)
+//
+// Perbedaan yang disengaja: urutan sort text memakai perbandingan byte, bukan
+// collation database, dan cursor hanya berlaku untuk Store yang membuatnya.
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+)
+
+// Store adalah "database" in-memory. Gunakan NewStore; zero value tidak bisa dipakai.
+type Store struct {
+	mu sync.RWMutex
+	// txMu menjalankan transaksi TxManager satu per satu
+	txMu sync.Mutex
+
+	categories     *table[models.Category]
+	roles          *table[models.Role]
+	sizes          *table[models.Size]
+	statuses       *table[models.Status]
+	warehouses     *table[models.Warehouse]
+	employees      *table[models.Employee]
+	products       *table[models.Product]
+	productDetails *table[models.ProductDetail]
+	inventories    *table[models.Inventory]
+	transactions   *table[models.Transaction]
+	details        *table[models.DetailTransaction]
+	auditLogs      *table[models.AuditLog]
+	refreshTokens  *table[models.RefreshToken]
+	revokedTokens  *table[revokedToken]
+
+	// transactionCodeSeq sama dengan sequence transaction_code_seq
+	transactionCodeSeq int64
+}
+
+// NewStore mengembalikan Store berisi data awal yang sama dengan database
+// yang baru dimigrasi (migration 000003, 000005, 000007 dan 000008)
+func NewStore() *Store {
+	s := &Store{
+		categories:     newTable[models.Category](),
+		roles:          newTable[models.Role](),
+		sizes:          newTable[models.Size](),
+		statuses:       newTable[models.Status](),
+		warehouses:     newTable[models.Warehouse](),
+		employees:      newTable[models.Employee](),
+		products:       newTable[models.Product](),
+		productDetails: newTable[models.ProductDetail](),
+		inventories:    newTable[models.Inventory](),
+		transactions:   newTable[models.Transaction](),
+		details:        newTable[models.DetailTransaction](),
+		auditLogs:      newTable[models.AuditLog](),
+		refreshTokens:  newTable[models.RefreshToken](),
+		revokedTokens:  newTable[revokedToken](),
+	}
+
+	for _, name := range []string{"shirt", "pants", "shoes"} {
+		s.categories.insert(func(id uint) models.Category { return models.Category{ID: id, Name: name} })
+	}
+	// permission role mengikuti migration 000007 dan 000008: role yang lebih tinggi
+	// mendapat semua permission role di bawahnya
+	var permissions []string
+	for _, role := range []struct {
+		name  string
+		grant []string
+	}{
+		{"employee", []string{"warehouse:read", "master:read", "product:read", "inventory:read", "transaction:read", "transaction:create", "transaction:scan"}},
+		{"manager", []string{"employee:read", "master:write", "product:write", "inventory:adjust", "transaction:dispatch", "transaction:receive", "transaction:complete", "transaction:status"}},
+		{"admin", []string{"employee:write", "employee:delete", "warehouse:write", "warehouse:delete", "warehouse:all", "master:delete", "product:delete", "role:read", "audit:read"}},
+		{"super admin", []string{"role:write", "role:delete"}},
+	} {
+		permissions = append(permissions, role.grant...)
+		granted := slices.Sorted(slices.Values(permissions))
+		s.roles.insert(func(id uint) models.Role { return models.Role{ID: id, RoleName: role.name, Permissions: granted} })
+	}
+	for _, name := range []string{"S", "M", "L", "XL", "2XL"} {
+		s.sizes.insert(func(id uint) models.Size { return models.Size{ID: id, Name: name} })
+	}
+	// id status mengikuti konstanta models.Status*
+	for range models.StatusNames {
+		s.statuses.insert(func(id uint) models.Status { return models.Status{ID: id, Name: models.StatusNames[id]} })
+	}
+	for _, code := range []string{"WH-01", "WH-02", "WH-03"} {
+		s.warehouses.insert(func(id uint) models.Warehouse {
+			return models.Warehouse{ID: id, WarehouseName: code, WarehouseCode: code}
+		})
+	}
+	s.employees.insert(func(id uint) models.Employee {
+		return models.Employee{
+			ID:            id,
+			UserID:        "resdox-uid",
+			EmployeeName:  "resdox",
+			Password:      "ganti_dengan_password_hash",
+			EmployeeCode:  "SA-001",
+			IDRole:        4,
+			WarehouseCode: "WH-01",
+		}
+	})
+
+	return s
+}
+
+// table adalah satu tabel dengan primary key serial
+type table[T any] struct {
+	rows   map[uint]T
+	nextID uint
+}
+
+func newTable[T any]() *table[T] {
+	return &table[T]{rows: map[uint]T{}, nextID: 1}
+}
+
+// insert menyimpan baris dari build yang menerima id baru, sama seperti kolom SERIAL
+func (t *table[T]) insert(build func(id uint) T) T {
+	row := build(t.nextID)
+	t.rows[t.nextID] = row
+	t.nextID++
+	return row
+}
+
+func (t *table[T]) get(id uint) (T, bool) {
+	row, ok := t.rows[id]
+	return row, ok
+}
+
+func (t *table[T]) put(id uint, row T) {
+	t.rows[id] = row
+}
+
+func (t *table[T]) delete(id uint) {
+	delete(t.rows, id)
+}
+
+// find mengembalikan id dan baris pertama (urut id) yang cocok dengan match
+func (t *table[T]) find(match func(T) bool) (uint, T, bool) {
+	for _, id := range t.ids() {
+		if row := t.rows[id]; match(row) {
+			return id, row, true
+		}
+	}
+	var zero T
+	return 0, zero, false
+}
+
+func (t *table[T]) exists(match func(T) bool) bool {
+	_, _, ok := t.find(match)
+	return ok
+}
+
+// filter mengembalikan semua baris yang cocok, urut id
+func (t *table[T]) filter(match func(T) bool) []T {
+	var rows []T
+	for _, id := range t.ids() {
+		if row := t.rows[id]; match == nil || match(row) {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// deleteWhere menghapus semua baris yang cocok (dipakai untuk ON DELETE CASCADE)
+func (t *table[T]) deleteWhere(match func(T) bool) {
+	for id, row := range t.rows {
+		if match(row) {
+			delete(t.rows, id)
+		}
+	}
+}
+
+func (t *table[T]) ids() []uint {
+	ids := make([]uint, 0, len(t.rows))
+	for id := range t.rows {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// snapshot menyalin isi tabel dan mengembalikan fungsi untuk mengembalikannya.
+// Seperti sequence PostgreSQL, id yang sudah terpakai tidak ikut dikembalikan.
+func (t *table[T]) snapshot() (restore func()) {
+	rows := make(map[uint]T, len(t.rows))
+	for id, row := range t.rows {
+		rows[id] = row
+	}
+	return func() { t.rows = rows }
+}
+
+// savepoint menyalin semua tabel; memanggil rollback mengembalikan isinya. Dipakai
+// operasi multi-langkah dan TxManager. Harus dipanggil saat lock dipegang.
+func (s *Store) savepoint() (rollback func()) {
+	restores := []func(){
+		s.categories.snapshot(), s.roles.snapshot(), s.sizes.snapshot(), s.statuses.snapshot(),
+		s.warehouses.snapshot(), s.employees.snapshot(), s.products.snapshot(), s.productDetails.snapshot(),
+		s.inventories.snapshot(), s.transactions.snapshot(), s.details.snapshot(), s.auditLogs.snapshot(),
+		s.refreshTokens.snapshot(), s.revokedTokens.snapshot(),
+	}
+	return func() {
+		for _, restore := range restores {
+			restore()
+		}
+	}
+}
+
+// lock mengambil lock tulis; ctx yang sudah dibatalkan ditolak seperti driver database
+func (s *Store) lock(ctx context.Context) (func(), error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	return s.mu.Unlock, nil
+}
+
+// rlock mengambil lock baca
+func (s *Store) rlock(ctx context.Context) (func(), error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	return s.mu.RUnlock, nil
+}
+
+// invalidReference dipakai jika data yang tidak ditemukan berasal dari body request
+// (foreign key), sama dengan invalidReference di package repository
+func invalidReference(notFound *apperror.Error) error {
+	return notFound.WithKind(apperror.KindValidation)
+}
@@ -0,0 +1,339 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+type TransactionRepositoryImpl struct {
+	store *Store
+}
+
+func NewTransactionRepository(store *Store) repository.TransactionRepository {
+	return &TransactionRepositoryImpl{store: store}
+}
+
+// FindByCode implements repository.TransactionRepository.
+func (r *TransactionRepositoryImpl) FindByCode(ctx context.Context, code string) (*models.Transaction, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	_, trx, ok := r.store.transactions.find(func(t models.Transaction) bool { return t.CodeTransaksi == code })
+	if !ok {
+		return nil, repository.ErrTransactionNotFound
+	}
+
+	status, _ := r.store.statuses.get(trx.IDStatus)
+	trx.Status = models.Status{ID: trx.IDStatus, Name: status.Name}
+
+	// detail diurutkan berdasarkan id seperti findDetails
+	for _, d := range r.store.details.filter(func(d models.DetailTransaction) bool { return d.IDTransaction == trx.ID }) {
+		pd := r.store.withProductAndSize(r.store.mustDetail(d.IDDetailProduct))
+		d.ProductDetail = models.ProductDetail{
+			ID:          d.IDDetailProduct,
+			CodeProduct: pd.CodeProduct,
+			IDSize:      pd.IDSize,
+			Barcode:     pd.Barcode,
+			Product:     models.Product{ProductName: pd.Product.ProductName, ProductCode: pd.CodeProduct},
+			Size:        pd.Size,
+		}
+		trx.Details = append(trx.Details, d)
+	}
+
+	return &trx, nil
+}
+
+// NextCodeSequence implements repository.TransactionRepository.
+func (r *TransactionRepositoryImpl) NextCodeSequence(ctx context.Context) (int64, error) {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	r.store.transactionCodeSeq++
+	return r.store.transactionCodeSeq, nil
+}
+
+// Save implements repository.TransactionRepository.
+func (r *TransactionRepositoryImpl) Save(ctx context.Context, trx *models.Transaction) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	rollback := r.store.savepoint()
+	if err := r.store.insertTransaction(trx); err != nil {
+		rollback()
+		return err
+	}
+	return nil
+}
+
+// SaveWithReservation implements repository.TransactionRepository.
+func (r *TransactionRepositoryImpl) SaveWithReservation(ctx context.Context, trx *models.Transaction, codeWarehouse string) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := r.store.reserveStock(trx.Details, codeWarehouse); err != nil {
+		return err
+	}
+
+	rollback := r.store.savepoint()
+	if err := r.store.insertTransaction(trx); err != nil {
+		rollback()
+		return err
+	}
+	return nil
+}
+
+// UpdateStatus implements repository.TransactionRepository.
+func (r *TransactionRepositoryImpl) UpdateStatus(ctx context.Context, id uint, fromStatus, toStatus uint, movements []repository.InventoryMovement) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	trx, ok := r.store.transactions.get(id)
+	if !ok {
+		return repository.ErrTransactionNotFound
+	}
+	if trx.IDStatus != fromStatus {
+		return repository.ErrTransactionStatusChanged
+	}
+
+	rollback := r.store.savepoint()
+	if err := r.store.applyMovements(movements); err != nil {
+		rollback()
+		return err
+	}
+
+	trx.IDStatus = toStatus
+	r.store.transactions.put(id, trx)
+	return nil
+}
+
+// Receive implements repository.TransactionRepository.
+func (r *TransactionRepositoryImpl) Receive(ctx context.Context, id uint, codeWarehouse string, items []models.DetailTransaction) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	trx, ok := r.store.transactions.get(id)
+	if !ok {
+		return repository.ErrTransactionNotFound
+	}
+	if trx.IDStatus != models.StatusInTransit {
+		return repository.ErrTransactionStatusChanged
+	}
+
+	rollback := r.store.savepoint()
+	if err := r.receive(trx, codeWarehouse, items); err != nil {
+		rollback()
+		return err
+	}
+	return nil
+}
+
+func (r *TransactionRepositoryImpl) receive(trx models.Transaction, codeWarehouse string, items []models.DetailTransaction) error {
+	movements := make([]repository.InventoryMovement, 0, len(items))
+	for _, item := range items {
+		key, line, ok := r.store.details.find(func(d models.DetailTransaction) bool {
+			return d.IDTransaction == trx.ID && d.IDDetailProduct == item.IDDetailProduct
+		})
+		if !ok {
+			return repository.ErrTransactionItemNotFound
+		}
+		if line.ReceivedQuantity+item.Quantity > line.Quantity {
+			return repository.ErrReceiveExceedsQuantity
+		}
+
+		line.ReceivedQuantity += item.Quantity
+		r.store.details.put(key, line)
+
+		pd := r.store.mustDetail(line.IDDetailProduct)
+		movements = append(movements, repository.InventoryMovement{
+			CodeProduct:   pd.CodeProduct,
+			IDSize:        pd.IDSize,
+			CodeWarehouse: codeWarehouse,
+			Delta:         item.Quantity,
+		})
+	}
+
+	if err := r.store.applyMovements(movements); err != nil {
+		return err
+	}
+
+	if !r.store.details.exists(func(d models.DetailTransaction) bool {
+		return d.IDTransaction == trx.ID && d.ReceivedQuantity < d.Quantity
+	}) {
+		trx.IDStatus = models.StatusCompleted
+		r.store.transactions.put(trx.ID, trx)
+	}
+	return nil
+}
+
+// Scan implements repository.TransactionRepository.
+func (r *TransactionRepositoryImpl) Scan(ctx context.Context, id uint, barcode string, quantity int) (uint, error) {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	_, pd, ok := r.store.productDetails.find(func(d models.ProductDetail) bool { return d.Barcode == barcode })
+	if !ok {
+		return 0, repository.ErrProductDetailNotFound
+	}
+	key, line, ok := r.store.details.find(func(d models.DetailTransaction) bool {
+		return d.IDTransaction == id && d.IDDetailProduct == pd.ID
+	})
+	if !ok {
+		return 0, repository.ErrTransactionItemNotFound
+	}
+	if line.ScannerQuantity+quantity > line.Quantity {
+		return 0, repository.ErrScanExceedsQuantity
+	}
+
+	line.ScannerQuantity += quantity
+	r.store.details.put(key, line)
+	return line.IDDetailProduct, nil
+}
+
+// insertTransaction menyimpan header dan detail dengan aturan constraint yang sama
+// seperti insertTransaction di package repository. Pemanggil harus memakai savepoint.
+func (s *Store) insertTransaction(trx *models.Transaction) error {
+	if s.transactions.exists(func(t models.Transaction) bool { return t.CodeTransaksi == trx.CodeTransaksi }) {
+		return repository.ErrDuplicate
+	}
+	if !s.employees.exists(func(e models.Employee) bool { return e.EmployeeCode == trx.EmployeeCode }) {
+		return invalidReference(repository.ErrEmployeeNotFound)
+	}
+	if _, ok := s.statuses.get(trx.IDStatus); !ok {
+		return repository.ErrInvalidReference
+	}
+
+	header := s.transactions.insert(func(id uint) models.Transaction {
+		return models.Transaction{
+			ID:                    id,
+			CodeTransaksi:         trx.CodeTransaksi,
+			OriginEntityName:      trx.OriginEntityName,
+			DestinationEntityName: trx.DestinationEntityName,
+			EmployeeCode:          trx.EmployeeCode,
+			IDStatus:              trx.IDStatus,
+			CreatedAt:             time.Now(),
+			TipeTransaksi:         trx.TipeTransaksi,
+		}
+	})
+	trx.ID, trx.CreatedAt = header.ID, header.CreatedAt
+
+	for i := range trx.Details {
+		detail := &trx.Details[i]
+		detail.IDTransaction = trx.ID
+
+		if _, ok := s.productDetails.get(detail.IDDetailProduct); !ok {
+			return repository.ErrProductDetailNotFound
+		}
+		if s.details.exists(func(d models.DetailTransaction) bool {
+			return d.IDTransaction == trx.ID && d.IDDetailProduct == detail.IDDetailProduct
+		}) {
+			return repository.ErrDuplicate
+		}
+
+		saved := s.details.insert(func(id uint) models.DetailTransaction {
+			return models.DetailTransaction{
+				ID:              id,
+				IDTransaction:   detail.IDTransaction,
+				IDDetailProduct: detail.IDDetailProduct,
+				Quantity:        detail.Quantity,
+			}
+		})
+		detail.ID = saved.ID
+	}
+
+	return nil
+}
+
+// reserveStock sama dengan reserveStock di package repository: stok tersedia adalah
+// quantity inventory dikurangi quantity dokumen Pending lain dari warehouse yang sama
+func (s *Store) reserveStock(details []models.DetailTransaction, codeWarehouse string) error {
+	shortage := &repository.StockShortageError{CodeWarehouse: codeWarehouse}
+
+	for i := range details {
+		pd, ok := s.productDetails.get(details[i].IDDetailProduct)
+		if !ok {
+			return repository.ErrProductDetailNotFound
+		}
+		details[i].ProductDetail.CodeProduct, details[i].ProductDetail.IDSize = pd.CodeProduct, pd.IDSize
+	}
+
+	order := make([]int, len(details))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		x, y := details[a].ProductDetail, details[b].ProductDetail
+		return cmp.Or(strings.Compare(x.CodeProduct, y.CodeProduct), cmp.Compare(x.IDSize, y.IDSize))
+	})
+
+	for _, i := range order {
+		detail := details[i]
+		codeProduct, idSize := detail.ProductDetail.CodeProduct, detail.ProductDetail.IDSize
+
+		_, inv, _ := s.inventories.find(func(i models.Inventory) bool {
+			return i.CodeProduct == codeProduct && i.IDSize == idSize && i.CodeWarehouse == codeWarehouse
+		})
+
+		reserved := 0
+		for _, t := range s.transactions.filter(func(t models.Transaction) bool {
+			return t.OriginEntityName == codeWarehouse &&
+				t.IDStatus == models.StatusPending &&
+				slices.Contains(models.ReservingTransactionTypes, t.TipeTransaksi)
+		}) {
+			for _, d := range s.details.filter(func(d models.DetailTransaction) bool { return d.IDTransaction == t.ID }) {
+				if pd := s.mustDetail(d.IDDetailProduct); pd.CodeProduct == codeProduct && pd.IDSize == idSize {
+					reserved += d.Quantity
+				}
+			}
+		}
+
+		available := max(inv.Quantity-reserved, 0)
+		if detail.Quantity > available {
+			shortage.Lines = append(shortage.Lines, repository.StockShortage{
+				IDDetailProduct: detail.IDDetailProduct,
+				CodeProduct:     codeProduct,
+				IDSize:          idSize,
+				Requested:       detail.Quantity,
+				Available:       available,
+				Shortfall:       detail.Quantity - available,
+			})
+		}
+	}
+
+	if len(shortage.Lines) > 0 {
+		return shortage
+	}
+	return nil
+}
+
+// mustDetail membaca variant yang dijamin ada oleh foreign key detail_transactions
+func (s *Store) mustDetail(id uint) models.ProductDetail {
+	pd, _ := s.productDetails.get(id)
+	return pd
+}
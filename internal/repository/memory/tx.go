@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+// txKey menandai ctx yang sedang berada di dalam transaksi Store tertentu
+type txKey struct{}
+
+type TxManagerImpl struct {
+	store *Store
+}
+
+// NewTxManager membuat repository.TxManager untuk Store. Transaksi dijalankan satu per
+// satu; jika fn gagal seluruh Store dikembalikan ke isi sebelum fn. Perubahan di luar
+// TxManager yang terjadi selama fn berjalan ikut hilang saat rollback, jadi jangan
+// mencampur keduanya secara bersamaan di test. Isolation, ReadOnly dan retry diabaikan.
+func NewTxManager(store *Store) repository.TxManager {
+	return &TxManagerImpl{store: store}
+}
+
+func (m *TxManagerImpl) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.WithinTxOptions(ctx, repository.TxOptions{}, fn)
+}
+
+func (m *TxManagerImpl) WithinTxOptions(ctx context.Context, _ repository.TxOptions, fn func(ctx context.Context) error) error {
+	// transaksi bersarang berlaku seperti savepoint: hanya perubahannya sendiri yang dibatalkan
+	if store, _ := ctx.Value(txKey{}).(*Store); store != m.store {
+		m.store.txMu.Lock()
+		defer m.store.txMu.Unlock()
+		ctx = context.WithValue(ctx, txKey{}, m.store)
+	}
+
+	unlock, err := m.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	rollback := m.store.savepoint()
+	unlock()
+
+	if err := fn(ctx); err != nil {
+		m.store.mu.Lock()
+		rollback()
+		m.store.mu.Unlock()
+		return err
+	}
+	return nil
+}
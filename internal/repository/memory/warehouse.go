@@ -0,0 +1,177 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+type WarehouseRepositoryImpl struct {
+	store *Store
+}
+
+func NewWarehouseRepository(store *Store) repository.WarehouseRepository {
+	return &WarehouseRepositoryImpl{store: store}
+}
+
+// warehouseList sama dengan sort dan filter FindAll warehouse di PostgreSQL
+var warehouseList = listSpec[models.Warehouse]{
+	key:         func(w models.Warehouse) uint { return w.ID },
+	defaultSort: "id",
+	sorts: map[string]sortField[models.Warehouse]{
+		"id":   intSort(func(w models.Warehouse) int64 { return int64(w.ID) }),
+		"name": textSort(func(w models.Warehouse) string { return w.WarehouseName }),
+		"code": textSort(func(w models.Warehouse) string { return w.WarehouseCode }),
+	},
+	filters: map[string]listFilter[models.Warehouse]{
+		"name": textFilter(filterContains, func(w models.Warehouse) string { return w.WarehouseName }),
+		"code": textFilter(filterEquals, func(w models.Warehouse) string { return w.WarehouseCode }),
+		// location_description NULL tidak pernah cocok dengan ILIKE
+		"location": {kind: filterContains, text: func(w models.Warehouse) (string, bool) {
+			return w.LocationDescription, w.LocationDescription != ""
+		}},
+	},
+}
+
+// FindAll implements repository.WarehouseRepository.
+func (r *WarehouseRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.Warehouse, pagination.Page, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, pagination.Page{}, err
+	}
+	defer unlock()
+
+	rows, page, err := findList(r.store.warehouses.filter(nil), warehouseList, p)
+	for i := range rows {
+		// query list PostgreSQL tidak membaca kolom id
+		rows[i].ID = 0
+	}
+	return pointers(rows), page, err
+}
+
+// FindById implements repository.WarehouseRepository.
+func (r *WarehouseRepositoryImpl) FindById(ctx context.Context, id string) (*models.Warehouse, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	_, wh, ok := r.store.warehouses.find(func(w models.Warehouse) bool { return w.WarehouseCode == id })
+	if !ok {
+		return nil, repository.ErrWarehouseNotFound
+	}
+	return &wh, nil
+}
+
+// Save implements repository.WarehouseRepository.
+func (r *WarehouseRepositoryImpl) Save(ctx context.Context, warehouse *models.Warehouse) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if r.store.warehouses.exists(func(w models.Warehouse) bool {
+		return w.WarehouseName == warehouse.WarehouseName || w.WarehouseCode == warehouse.WarehouseCode
+	}) {
+		return repository.ErrDuplicate
+	}
+
+	saved := r.store.warehouses.insert(func(id uint) models.Warehouse {
+		return models.Warehouse{
+			ID:                  id,
+			WarehouseName:       warehouse.WarehouseName,
+			WarehouseCode:       warehouse.WarehouseCode,
+			LocationDescription: warehouse.LocationDescription,
+		}
+	})
+	warehouse.ID = saved.ID
+	return nil
+}
+
+// Update implements repository.WarehouseRepository.
+func (r *WarehouseRepositoryImpl) Update(ctx context.Context, warehouse map[string]any, code string) error {
+	allowedColumns := map[string]bool{
+		"warehouse_name":       true,
+		"location_description": true,
+	}
+
+	updates := map[string]string{}
+	for column, value := range warehouse {
+		if !allowedColumns[column] {
+			return repository.ErrNoFieldsToUpdate.Wrap(fmt.Errorf("column '%s' is not allowed to be updated", column))
+		}
+		if value == nil {
+			continue
+		}
+		if strVal, ok := value.(string); ok && strVal == "" {
+			continue
+		}
+		updates[column] = fmt.Sprint(value)
+	}
+	if len(updates) == 0 {
+		return repository.ErrNoFieldsToUpdate
+	}
+
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	id, wh, ok := r.store.warehouses.find(func(w models.Warehouse) bool { return w.WarehouseCode == code })
+	if !ok {
+		return repository.ErrWarehouseNotFound
+	}
+
+	if name, ok := updates["warehouse_name"]; ok {
+		if r.store.warehouses.exists(func(w models.Warehouse) bool { return w.ID != id && w.WarehouseName == name }) {
+			return fmt.Errorf("failed to update warehouse: %w", repository.ErrDuplicate)
+		}
+		wh.WarehouseName = name
+	}
+	if location, ok := updates["location_description"]; ok {
+		wh.LocationDescription = location
+	}
+
+	r.store.warehouses.put(id, wh)
+	return nil
+}
+
+// Delete implements repository.WarehouseRepository.
+func (r *WarehouseRepositoryImpl) Delete(ctx context.Context, id string) error {
+	unlock, err := r.store.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	key, _, ok := r.store.warehouses.find(func(w models.Warehouse) bool { return w.WarehouseCode == id })
+	if !ok {
+		return repository.ErrWarehouseNotFound
+	}
+	// employee.warehouse_code ON DELETE RESTRICT
+	if r.store.employees.exists(func(e models.Employee) bool { return e.WarehouseCode == id }) {
+		return repository.ErrStillReferenced
+	}
+
+	// inventory.code_warehouse ON DELETE CASCADE
+	r.store.inventories.deleteWhere(func(i models.Inventory) bool { return i.CodeWarehouse == id })
+	r.store.warehouses.delete(key)
+	return nil
+}
+
+// ExistsByCode implements repository.WarehouseRepository.
+func (r *WarehouseRepositoryImpl) ExistsByCode(ctx context.Context, code string) (bool, error) {
+	unlock, err := r.store.rlock(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	return r.store.warehouses.exists(func(w models.Warehouse) bool { return w.WarehouseCode == code }), nil
+}
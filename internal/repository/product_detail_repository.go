@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+)
+
+type ProductDetailRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewProductDetailRepository(db *sql.DB) ProductDetailRepository {
+	return &ProductDetailRepositoryImpl{
+		db: db,
+	}
+}
+
+// product detail selalu dibaca bersama product, size dan category
+// supaya hasil scan barcode bisa langsung ditampilkan dalam satu query
+const productDetailSelect = `
+		SELECT
+			pd.id, pd.code_product, pd.id_size, pd.barcode, s.name,
+			p.id, p.product_name, p.price, COALESCE(p.description_product, ''),
+			p.product_code, p.id_category, c.name
+		FROM
+			product_detail pd
+		JOIN
+			product p ON p.product_code = pd.code_product
+		JOIN
+			size s ON s.id = pd.id_size
+		JOIN
+			category c ON c.id = p.id_category`
+
+// FindAllByProduct implements ProductDetailRepository.
+func (r *ProductDetailRepositoryImpl) FindAllByProduct(ctx context.Context, codeProduct string) ([]*models.ProductDetail, error) {
+	query := productDetailSelect + ` WHERE pd.code_product = $1 ORDER BY pd.id_size`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, codeProduct)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method FindAllByProduct product detail in repository layer", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var details []*models.ProductDetail
+	for rows.Next() {
+		detail := &models.ProductDetail{}
+		if err := scanProductDetail(rows, detail); err != nil {
+			slog.ErrorContext(ctx, "error on method FindAllByProduct product detail in repository layer", "error", err)
+			return nil, err
+		}
+		details = append(details, detail)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
+
+// FindById implements ProductDetailRepository.
+func (r *ProductDetailRepositoryImpl) FindById(ctx context.Context, id int) (*models.ProductDetail, error) {
+	query := productDetailSelect + ` WHERE pd.id = $1`
+
+	return r.queryProductDetail(ctx, "FindById", query, id)
+}
+
+// FindByBarcode implements ProductDetailRepository.
+func (r *ProductDetailRepositoryImpl) FindByBarcode(ctx context.Context, barcode string) (*models.ProductDetail, error) {
+	query := productDetailSelect + ` WHERE pd.barcode = $1`
+
+	return r.queryProductDetail(ctx, "FindByBarcode", query, barcode)
+}
+
+// ExistsByProductAndSize implements ProductDetailRepository.
+func (r *ProductDetailRepositoryImpl) ExistsByProductAndSize(ctx context.Context, codeProduct string, idSize int) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM product_detail WHERE code_product = $1 AND id_size = $2)`
+
+	var exists bool
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, codeProduct, idSize).Scan(&exists); err != nil {
+		slog.ErrorContext(ctx, "error on method ExistsByProductAndSize product detail in repository layer", "error", err)
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// Save implements ProductDetailRepository.
+func (r *ProductDetailRepositoryImpl) Save(ctx context.Context, detail *models.ProductDetail) error {
+	query := `
+		INSERT INTO product_detail
+			(code_product, id_size, barcode)
+		VALUES
+			($1, $2, $3)
+		RETURNING
+			id`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		detail.CodeProduct,
+		detail.IDSize,
+		detail.Barcode,
+	).Scan(&detail.ID)
+
+	if err != nil {
+		switch {
+		case isPgError(err, pgUniqueViolation) && pgConstraint(err) == "product_detail_barcode_key":
+			return ErrBarcodeExists
+		case isPgError(err, pgUniqueViolation):
+			return ErrProductDetailExists
+		case isPgError(err, pgForeignKeyViolation) && pgConstraint(err) == "product_detail_id_size_fkey":
+			return invalidReference(ErrSizeNotFound, err)
+		case isPgError(err, pgForeignKeyViolation):
+			return ErrProductNotFound
+		}
+		slog.ErrorContext(ctx, "error on method Save product detail in repository layer", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// Delete implements ProductDetailRepository.
+func (r *ProductDetailRepositoryImpl) Delete(ctx context.Context, id int) error {
+	query := `DELETE FROM product_detail WHERE id = $1`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method Delete product detail in repository layer", "error", err)
+		return dbError(err, nil)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrProductDetailNotFound
+	}
+
+	return nil
+}
+
+func (r *ProductDetailRepositoryImpl) queryProductDetail(ctx context.Context, method, query string, args ...any) (*models.ProductDetail, error) {
+	detail := &models.ProductDetail{}
+
+	if err := scanProductDetail(conn(ctx, r.db).QueryRowContext(ctx, query, args...), detail); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProductDetailNotFound
+		}
+		slog.ErrorContext(ctx, "error on product detail method in repository layer", "method", method, "error", err)
+		return nil, err
+	}
+
+	return detail, nil
+}
+
+// scanProductDetail membaca satu baris hasil productDetailSelect ke dalam model
+func scanProductDetail(row interface{ Scan(dest ...any) error }, detail *models.ProductDetail) error {
+	err := row.Scan(
+		&detail.ID,
+		&detail.CodeProduct,
+		&detail.IDSize,
+		&detail.Barcode,
+		&detail.Size.Name,
+		&detail.Product.ID,
+		&detail.Product.ProductName,
+		&detail.Product.Price,
+		&detail.Product.DescriptionProduct,
+		&detail.Product.ProductCode,
+		&detail.Product.IDCategory,
+		&detail.Product.Category.Name,
+	)
+	if err != nil {
+		return err
+	}
+
+	detail.Size.ID = detail.IDSize
+	detail.Product.Category.ID = detail.Product.IDCategory
+
+	return nil
+}
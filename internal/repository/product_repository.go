@@ -1 +1,227 @@
 package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+)
+
+type ProductRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewProductRepository(db *sql.DB) ProductRepository {
+	return &ProductRepositoryImpl{
+		db: db,
+	}
+}
+
+// kolom yang selalu diambil saat membaca product (beserta nama category)
+const (
+	productColumns = `
+			p.id, p.product_name, p.price, COALESCE(p.description_product, ''),
+			p.product_code, p.id_category, c.name`
+	productFrom = `
+			product p
+		JOIN
+			category c ON c.id = p.id_category`
+	productSelect = `
+		SELECT` + productColumns + `
+		FROM` + productFrom
+)
+
+// productList adalah sort dan filter yang didukung FindAll product
+var productList = listSpec{
+	columns:     productColumns,
+	from:        productFrom,
+	key:         `p.id`,
+	defaultSort: "id",
+	sorts: map[string]string{
+		"id":    `p.id`,
+		"name":  `p.product_name`,
+		"code":  `p.product_code`,
+		"price": `p.price`,
+	},
+	filters: map[string]listFilter{
+		"name":        {column: `p.product_name`, kind: filterContains},
+		"code":        {column: `p.product_code`, kind: filterEquals},
+		"id_category": {column: `p.id_category`, kind: filterInt},
+		"category":    {column: `c.name`, kind: filterContains},
+	},
+}
+
+// FindAll implements ProductRepository.
+func (r *ProductRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.Product, pagination.Page, error) {
+	return findList(ctx, conn(ctx, r.db), "FindAll product", productList, p, func(rows *sql.Rows, extra ...any) (*models.Product, error) {
+		product := &models.Product{}
+		err := scanProduct(rows, product, extra...)
+		return product, err
+	})
+}
+
+// FindById implements ProductRepository.
+func (r *ProductRepositoryImpl) FindById(ctx context.Context, id int) (*models.Product, error) {
+	query := productSelect + ` WHERE p.id = $1`
+
+	return r.queryProduct(ctx, "FindById", query, id)
+}
+
+// FindByCode implements ProductRepository.
+func (r *ProductRepositoryImpl) FindByCode(ctx context.Context, code string) (*models.Product, error) {
+	query := productSelect + ` WHERE p.product_code = $1`
+
+	return r.queryProduct(ctx, "FindByCode", query, code)
+}
+
+// Save implements ProductRepository.
+func (r *ProductRepositoryImpl) Save(ctx context.Context, product *models.Product) error {
+	query := `
+		INSERT INTO product
+			(product_name, price, description_product, product_code, id_category)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING
+			id`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		product.ProductName,
+		product.Price,
+		product.DescriptionProduct,
+		product.ProductCode,
+		product.IDCategory,
+	).Scan(&product.ID)
+
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) {
+			return invalidReference(ErrCategoryNotFound, err)
+		}
+		slog.ErrorContext(ctx, "error on method Save product in repository layer", "error", err)
+		return dbError(err, nil)
+	}
+
+	return nil
+}
+
+// Update implements ProductRepository.
+func (r *ProductRepositoryImpl) Update(ctx context.Context, product *models.Product) error {
+	query := `
+		UPDATE product
+		SET
+			product_name = $1,
+			price = $2,
+			description_product = $3,
+			id_category = $4
+		WHERE
+			id = $5`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
+		product.ProductName,
+		product.Price,
+		product.DescriptionProduct,
+		product.IDCategory,
+		product.ID,
+	)
+
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) {
+			return invalidReference(ErrCategoryNotFound, err)
+		}
+		slog.ErrorContext(ctx, "error on method Update product in repository layer", "error", err)
+		return dbError(err, nil)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrProductNotFound
+	}
+
+	return nil
+}
+
+// Delete implements ProductRepository.
+func (r *ProductRepositoryImpl) Delete(ctx context.Context, id int) error {
+	query := `DELETE FROM product WHERE id = $1`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method Delete product in repository layer", "error", err)
+		return dbError(err, nil)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrProductNotFound
+	}
+
+	return nil
+}
+
+func (r *ProductRepositoryImpl) queryProducts(ctx context.Context, method, query string, args ...any) ([]*models.Product, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on product method in repository layer", "method", method, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var products []*models.Product
+	for rows.Next() {
+		product := &models.Product{}
+		if err := scanProduct(rows, product); err != nil {
+			slog.ErrorContext(ctx, "error on product method in repository layer", "method", method, "error", err)
+			return nil, err
+		}
+		products = append(products, product)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func (r *ProductRepositoryImpl) queryProduct(ctx context.Context, method, query string, args ...any) (*models.Product, error) {
+	product := &models.Product{}
+
+	if err := scanProduct(conn(ctx, r.db).QueryRowContext(ctx, query, args...), product); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProductNotFound
+		}
+		slog.ErrorContext(ctx, "error on product method in repository layer", "method", method, "error", err)
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// scanProduct membaca satu baris hasil productSelect ke dalam model
+// extra ikut di-scan setelah kolom product, dipakai oleh FindAll untuk nilai cursor
+func scanProduct(row interface{ Scan(dest ...any) error }, product *models.Product, extra ...any) error {
+	err := row.Scan(append([]any{
+		&product.ID,
+		&product.ProductName,
+		&product.Price,
+		&product.DescriptionProduct,
+		&product.ProductCode,
+		&product.IDCategory,
+		&product.Category.Name,
+	}, extra...)...)
+	if err != nil {
+		return err
+	}
+
+	product.Category.ID = product.IDCategory
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+)
+
+type RoleRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewRoleRepository(db *sql.DB) RoleRepository {
+	return &RoleRepositoryImpl{
+		db: db,
+	}
+}
+
+// roleList adalah sort dan filter yang didukung FindAll role
+var roleList = listSpec{
+	columns:     `id, role_name`,
+	from:        `role`,
+	key:         `id`,
+	defaultSort: "id",
+	sorts: map[string]string{
+		"id":   `id`,
+		"name": `role_name`,
+	},
+	filters: map[string]listFilter{
+		"name": {column: `role_name`, kind: filterContains},
+	},
+}
+
+// FindAll implements RoleRepository.
+func (r *RoleRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.Role, pagination.Page, error) {
+	roles, page, err := findList(ctx, conn(ctx, r.db), "FindAll role", roleList, p, func(rows *sql.Rows, extra ...any) (*models.Role, error) {
+		role := &models.Role{}
+		err := rows.Scan(append([]any{&role.ID, &role.RoleName}, extra...)...)
+		return role, err
+	})
+	if err != nil {
+		return nil, page, err
+	}
+
+	permissions, err := r.FindAllPermissions(ctx)
+	if err != nil {
+		return nil, page, err
+	}
+
+	for _, role := range roles {
+		role.Permissions = permissions[role.ID]
+	}
+
+	return roles, page, nil
+}
+
+// FindById implements RoleRepository.
+func (r *RoleRepositoryImpl) FindById(ctx context.Context, id int) (*models.Role, error) {
+	role := &models.Role{}
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, `SELECT id, role_name FROM role WHERE id = $1`, id).Scan(&role.ID, &role.RoleName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRoleNotFound
+		}
+		slog.ErrorContext(ctx, "error on FindById Role in repository layer", "error", err)
+		return nil, err
+	}
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT permission FROM role_permission WHERE id_role = $1 ORDER BY permission`, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on FindById Role in repository layer when get permissions", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	role.Permissions = []string{}
+	for rows.Next() {
+		var permission string
+		if err := rows.Scan(&permission); err != nil {
+			return nil, err
+		}
+		role.Permissions = append(role.Permissions, permission)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// Save implements RoleRepository.
+func (r *RoleRepositoryImpl) Save(ctx context.Context, role *models.Role) error {
+	tx, err := beginTx(ctx, r.db, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on Save Role in repository layer when begin transaction", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `INSERT INTO role (role_name) VALUES ($1) RETURNING id`, role.RoleName).Scan(&role.ID)
+	if err != nil {
+		if isPgError(err, pgUniqueViolation) {
+			return ErrRoleNameExists
+		}
+		slog.ErrorContext(ctx, "error on Save Role in repository layer", "error", err)
+		return err
+	}
+
+	if err := insertRolePermissions(ctx, tx, role.ID, role.Permissions); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "error on Save Role in repository layer when commit", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// Update implements RoleRepository.
+func (r *RoleRepositoryImpl) Update(ctx context.Context, role *models.Role) error {
+	result, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE role SET role_name = $1 WHERE id = $2`, role.RoleName, role.ID)
+	if err != nil {
+		if isPgError(err, pgUniqueViolation) {
+			return ErrRoleNameExists
+		}
+		slog.ErrorContext(ctx, "error on Update Role in repository layer", "error", err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrRoleNotFound
+	}
+
+	return nil
+}
+
+// SetPermissions implements RoleRepository.
+func (r *RoleRepositoryImpl) SetPermissions(ctx context.Context, id int, permissions []string) error {
+	tx, err := beginTx(ctx, r.db, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on SetPermissions Role in repository layer when begin transaction", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	// kunci role supaya dua perubahan permission yang bersamaan tidak saling menimpa sebagian
+	var roleID uint
+	err = tx.QueryRowContext(ctx, `SELECT id FROM role WHERE id = $1 FOR UPDATE`, id).Scan(&roleID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrRoleNotFound
+		}
+		slog.ErrorContext(ctx, "error on SetPermissions Role in repository layer when lock role", "error", err)
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM role_permission WHERE id_role = $1`, roleID); err != nil {
+		slog.ErrorContext(ctx, "error on SetPermissions Role in repository layer when delete permissions", "error", err)
+		return err
+	}
+
+	if err := insertRolePermissions(ctx, tx, roleID, permissions); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "error on SetPermissions Role in repository layer when commit", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// Delete implements RoleRepository.
+func (r *RoleRepositoryImpl) Delete(ctx context.Context, id int) error {
+	// cek lebih dulu supaya error-nya jelas; foreign key tetap menjadi pengaman terakhir
+	var used bool
+	if err := conn(ctx, r.db).QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM employee WHERE id_role = $1)`, id).Scan(&used); err != nil {
+		slog.ErrorContext(ctx, "error on Delete Role in repository layer when check employee", "error", err)
+		return err
+	}
+	if used {
+		return ErrRoleInUse
+	}
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM role WHERE id = $1`, id)
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) {
+			return ErrRoleInUse
+		}
+		slog.ErrorContext(ctx, "error on Delete Role in repository layer", "error", err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrRoleNotFound
+	}
+
+	return nil
+}
+
+// FindAllPermissions implements RoleRepository.
+func (r *RoleRepositoryImpl) FindAllPermissions(ctx context.Context) (map[uint][]string, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT id_role, permission FROM role_permission ORDER BY id_role, permission`)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on FindAllPermissions Role in repository layer", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	permissions := make(map[uint][]string)
+	for rows.Next() {
+		var (
+			roleID     uint
+			permission string
+		)
+		if err := rows.Scan(&roleID, &permission); err != nil {
+			slog.ErrorContext(ctx, "error on FindAllPermissions Role in repository layer", "error", err)
+			return nil, err
+		}
+		permissions[roleID] = append(permissions[roleID], permission)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+// insertRolePermissions menyimpan permission role menggunakan koneksi q
+func insertRolePermissions(ctx context.Context, q dbtx, roleID uint, permissions []string) error {
+	for _, permission := range permissions {
+		_, err := q.ExecContext(ctx,
+			`INSERT INTO role_permission (id_role, permission) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+			roleID, permission,
+		)
+		if err != nil {
+			slog.ErrorContext(ctx, "error on insertRolePermissions in repository layer", "error", err)
+			return err
+		}
+	}
+
+	return nil
+}
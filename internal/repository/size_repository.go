@@ -3,9 +3,11 @@ package repository
 import (
 	"context"
 	"database/sql"
-	"log"
+	"errors"
+	"log/slog"
 
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
 )
 
 type SizeRepositoryImpl struct {
@@ -16,54 +18,63 @@ type SizeRepositoryImpl struct {
 func (s *SizeRepositoryImpl) Delete(ctx context.Context, id int) error {
 	query := `DELETE FROM size WHERE id = $1`
 
-	_, err := s.db.ExecContext(ctx, query, id)
+	result, err := s.db.ExecContext(ctx, query, id)
 	if err != nil {
-		log.Println("error when delete size on repository layer", err)
-		return err
+		slog.ErrorContext(ctx, "error when delete size on repository layer", "error", err)
+		return dbError(err, nil)
 	}
 
-	return nil
+	return notFoundIfNoRows(result, ErrSizeNotFound)
 }
 
-// FindAll implements SizeRepository.
-func (s *SizeRepositoryImpl) FindAll(ctx context.Context) ([]*models.Size, error) {
-	query := `SELECT id, name FROM size`
-
-	rows, err := s.db.QueryContext(ctx, query)
-	if err != nil {
-		log.Println("error when find all size on repository layer", err)
-		return nil, err
-	}
-
-	defer rows.Close()
+// sizeList adalah sort dan filter yang didukung FindAll size
+var sizeList = listSpec{
+	columns:     `id, name`,
+	from:        `size`,
+	key:         `id`,
+	defaultSort: "id",
+	sorts: map[string]string{
+		"id":   `id`,
+		"name": `name`,
+	},
+	filters: map[string]listFilter{
+		"name": {column: `name`, kind: filterContains},
+	},
+}
 
-	var sizes []*models.Size
-	for rows.Next() {
+// FindAll implements SizeRepository.
+func (s *SizeRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.Size, pagination.Page, error) {
+	return findList(ctx, s.db, "FindAll size", sizeList, p, func(rows *sql.Rows, extra ...any) (*models.Size, error) {
 		size := &models.Size{}
-		if err := rows.Scan(&size.ID, &size.Name); err != nil {
-			log.Println("error when find all size on repository layer", err)
-			return nil, err
-		}
+		err := rows.Scan(append([]any{&size.ID, &size.Name}, extra...)...)
+		return size, err
+	})
+}
 
-		sizes = append(sizes, size)
-	}
+// FindById implements SizeRepository.
+func (s *SizeRepositoryImpl) FindById(ctx context.Context, id int) (*models.Size, error) {
+	query := `SELECT id, name FROM size WHERE id = $1`
 
-	if err = rows.Err(); err != nil {
-		return nil, err
+	size := &models.Size{}
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&size.ID, &size.Name)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.ErrorContext(ctx, "error when find size on repository layer", "error", err)
+		}
+		return nil, dbError(err, ErrSizeNotFound)
 	}
 
-	return sizes, nil
-
+	return size, nil
 }
 
 // Save implements SizeRepository.
 func (s *SizeRepositoryImpl) Save(ctx context.Context, size *models.Size) error {
-	query := `INSERT INTO size (name) VALUES ($1)`
+	query := `INSERT INTO size (name) VALUES ($1) RETURNING id`
 
-	_, err := s.db.ExecContext(ctx, query, size.Name)
+	err := s.db.QueryRowContext(ctx, query, size.Name).Scan(&size.ID)
 	if err != nil {
-		log.Println("error when save size on repository layer", err)
-		return err
+		slog.ErrorContext(ctx, "error when save size on repository layer", "error", err)
+		return dbError(err, nil)
 	}
 
 	return nil
@@ -73,14 +84,14 @@ func (s *SizeRepositoryImpl) Save(ctx context.Context, size *models.Size) error
 func (s *SizeRepositoryImpl) Update(ctx context.Context, size *models.Size) error {
 	query := `UPDATE size SET name = $1 WHERE id = $2`
 
-	_, err := s.db.ExecContext(ctx, query, size.Name, size.ID)
+	result, err := s.db.ExecContext(ctx, query, size.Name, size.ID)
 
 	if err != nil {
-		log.Println("error when update size on repository layer", err)
-		return err
+		slog.ErrorContext(ctx, "error when update size on repository layer", "error", err)
+		return dbError(err, nil)
 	}
 
-	return nil
+	return notFoundIfNoRows(result, ErrSizeNotFound)
 }
 
 func NewSizeRepository(db *sql.DB) SizeRepository {
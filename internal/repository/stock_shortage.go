@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StockShortage adalah kekurangan stok untuk satu baris dokumen transaksi
+type StockShortage struct {
+	IDDetailProduct uint   `json:"id_detail_product"`
+	CodeProduct     string `json:"code_product"`
+	IDSize          uint   `json:"id_size"`
+	Requested       int    `json:"requested"`
+	Available       int    `json:"available"`
+	Shortfall       int    `json:"shortfall"`
+}
+
+// StockShortageError berisi semua baris yang quantity-nya melebihi stok tersedia
+type StockShortageError struct {
+	CodeWarehouse string
+	Lines         []StockShortage
+}
+
+func (e *StockShortageError) Error() string {
+	parts := make([]string, 0, len(e.Lines))
+	for _, l := range e.Lines {
+		parts = append(parts, fmt.Sprintf("detail %d: requested %d, available %d", l.IDDetailProduct, l.Requested, l.Available))
+	}
+
+	return fmt.Sprintf("insufficient stock in warehouse %s (%s)", e.CodeWarehouse, strings.Join(parts, "; "))
+}
+
+// Unwrap membuat errors.Is(err, ErrInsufficientStock) tetap bernilai true dan
+// response-nya memakai status dan code ErrInsufficientStock
+func (e *StockShortageError) Unwrap() error {
+	return ErrInsufficientStock
+}
+
+// Details menampilkan rincian baris yang stoknya kurang di response
+func (e *StockShortageError) Details() any {
+	return e.Lines
+}
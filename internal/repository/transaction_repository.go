@@ -0,0 +1,558 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"sort"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/lib/pq"
+)
+
+type TransactionRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewTransactionRepository(db *sql.DB) TransactionRepository {
+	return &TransactionRepositoryImpl{
+		db: db,
+	}
+}
+
+// FindByCode implements TransactionRepository.
+func (r *TransactionRepositoryImpl) FindByCode(ctx context.Context, code string) (*models.Transaction, error) {
+	query := `
+		SELECT
+			t.id, t.code_transaksi, t.origin_entity_name, COALESCE(t.destination_entity_name, ''),
+			t.employee_code, t.id_status, s.name, t.created_at, COALESCE(t.tipe_transaksi, '')
+		FROM
+			transactions t
+		JOIN
+			status s ON s.id = t.id_status
+		WHERE
+			t.code_transaksi = $1`
+
+	trx := &models.Transaction{}
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, code).Scan(
+		&trx.ID,
+		&trx.CodeTransaksi,
+		&trx.OriginEntityName,
+		&trx.DestinationEntityName,
+		&trx.EmployeeCode,
+		&trx.IDStatus,
+		&trx.Status.Name,
+		&trx.CreatedAt,
+		&trx.TipeTransaksi,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTransactionNotFound
+		}
+		slog.ErrorContext(ctx, "error on method FindByCode transaction in repository layer", "error", err)
+		return nil, err
+	}
+	trx.Status.ID = trx.IDStatus
+
+	details, err := r.findDetails(ctx, trx.ID)
+	if err != nil {
+		return nil, err
+	}
+	trx.Details = details
+
+	return trx, nil
+}
+
+// NextCodeSequence implements TransactionRepository.
+func (r *TransactionRepositoryImpl) NextCodeSequence(ctx context.Context) (int64, error) {
+	var seq int64
+	if err := conn(ctx, r.db).QueryRowContext(ctx, `SELECT nextval('transaction_code_seq')`).Scan(&seq); err != nil {
+		slog.ErrorContext(ctx, "error on method NextCodeSequence transaction in repository layer", "error", err)
+		return 0, err
+	}
+
+	return seq, nil
+}
+
+// Save implements TransactionRepository.
+func (r *TransactionRepositoryImpl) Save(ctx context.Context, trx *models.Transaction) error {
+	tx, err := beginTx(ctx, r.db, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method Save transaction in repository layer when begin transaction", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := insertTransaction(ctx, tx, trx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "error on method Save transaction in repository layer when commit", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// SaveWithReservation implements TransactionRepository.
+func (r *TransactionRepositoryImpl) SaveWithReservation(ctx context.Context, trx *models.Transaction, codeWarehouse string) error {
+	tx, err := beginTx(ctx, r.db, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method SaveWithReservation transaction in repository layer when begin transaction", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	// 1. Cek ketersediaan stok setiap baris sambil mengunci baris inventory-nya
+	if err := reserveStock(ctx, tx, trx.Details, codeWarehouse); err != nil {
+		return err
+	}
+
+	// 2. Simpan dokumen; baris Pending inilah yang menjadi reservasi stok
+	if err := insertTransaction(ctx, tx, trx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "error on method SaveWithReservation transaction in repository layer when commit", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateStatus implements TransactionRepository.
+func (r *TransactionRepositoryImpl) UpdateStatus(ctx context.Context, id uint, fromStatus, toStatus uint, movements []InventoryMovement) error {
+	tx, err := beginTx(ctx, r.db, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method UpdateStatus transaction in repository layer when begin transaction", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	// 1. Kunci header agar dua request complete yang bersamaan tidak menjalankan stok dua kali
+	var current uint
+	err = tx.QueryRowContext(ctx, `SELECT id_status FROM transactions WHERE id = $1 FOR UPDATE`, id).Scan(&current)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTransactionNotFound
+		}
+		slog.ErrorContext(ctx, "error on method UpdateStatus transaction in repository layer when lock row", "error", err)
+		return err
+	}
+
+	if current != fromStatus {
+		return ErrTransactionStatusChanged
+	}
+
+	// 2. Jalankan perubahan stok
+	if err := applyMovements(ctx, tx, movements); err != nil {
+		return err
+	}
+
+	// 3. Simpan status baru
+	if _, err := tx.ExecContext(ctx, `UPDATE transactions SET id_status = $1 WHERE id = $2`, toStatus, id); err != nil {
+		slog.ErrorContext(ctx, "error on method UpdateStatus transaction in repository layer when update status", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "error on method UpdateStatus transaction in repository layer when commit", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// Receive implements TransactionRepository.
+func (r *TransactionRepositoryImpl) Receive(ctx context.Context, id uint, codeWarehouse string, items []models.DetailTransaction) error {
+	tx, err := beginTx(ctx, r.db, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method Receive transaction in repository layer when begin transaction", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	// 1. Kunci header; hanya dokumen In Transit yang boleh diterima
+	var current uint
+	err = tx.QueryRowContext(ctx, `SELECT id_status FROM transactions WHERE id = $1 FOR UPDATE`, id).Scan(&current)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTransactionNotFound
+		}
+		slog.ErrorContext(ctx, "error on method Receive transaction in repository layer when lock row", "error", err)
+		return err
+	}
+
+	if current != models.StatusInTransit {
+		return ErrTransactionStatusChanged
+	}
+
+	// 2. Tambah received_quantity setiap baris, tidak boleh melebihi quantity dokumen
+	movements := make([]InventoryMovement, 0, len(items))
+	for _, item := range items {
+		movement := InventoryMovement{CodeWarehouse: codeWarehouse, Delta: item.Quantity}
+
+		err := tx.QueryRowContext(ctx, `
+			UPDATE
+				detail_transactions dt
+			SET
+				received_quantity = dt.received_quantity + $3
+			FROM
+				product_detail pd
+			WHERE
+				pd.id = dt.id_detail_product
+				AND dt.id_transaction = $1
+				AND dt.id_detail_product = $2
+				AND dt.received_quantity + $3 <= dt.quantity
+			RETURNING
+				pd.code_product, pd.id_size`,
+			id, item.IDDetailProduct, item.Quantity,
+		).Scan(&movement.CodeProduct, &movement.IDSize)
+
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return receiveLineError(ctx, tx, id, item.IDDetailProduct)
+			}
+			slog.ErrorContext(ctx, "error on method Receive transaction in repository layer when update received quantity", "error", err)
+			return err
+		}
+
+		movements = append(movements, movement)
+	}
+
+	// 3. Stok warehouse tujuan bertambah sebanyak yang diterima
+	if err := applyMovements(ctx, tx, movements); err != nil {
+		return err
+	}
+
+	// 4. Dokumen selesai jika semua baris sudah diterima penuh
+	var remaining int
+	err = tx.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM detail_transactions WHERE id_transaction = $1 AND received_quantity < quantity`,
+		id,
+	).Scan(&remaining)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method Receive transaction in repository layer when count remaining lines", "error", err)
+		return err
+	}
+
+	if remaining == 0 {
+		if _, err := tx.ExecContext(ctx, `UPDATE transactions SET id_status = $1 WHERE id = $2`, models.StatusCompleted, id); err != nil {
+			slog.ErrorContext(ctx, "error on method Receive transaction in repository layer when update status", "error", err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "error on method Receive transaction in repository layer when commit", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// Scan implements TransactionRepository.
+func (r *TransactionRepositoryImpl) Scan(ctx context.Context, id uint, barcode string, quantity int) (uint, error) {
+	// satu statement UPDATE supaya dua scanner yang bersamaan tidak bisa melewati quantity dokumen
+	query := `
+		UPDATE
+			detail_transactions dt
+		SET
+			scanner_quantity = dt.scanner_quantity + $3
+		FROM
+			product_detail pd
+		WHERE
+			pd.id = dt.id_detail_product
+			AND dt.id_transaction = $1
+			AND pd.barcode = $2
+			AND dt.scanner_quantity + $3 <= dt.quantity
+		RETURNING
+			dt.id_detail_product`
+
+	var idDetailProduct uint
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, barcode, quantity).Scan(&idDetailProduct)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, r.scanLineError(ctx, id, barcode)
+		}
+		slog.ErrorContext(ctx, "error on method Scan transaction in repository layer", "error", err)
+		return 0, err
+	}
+
+	return idDetailProduct, nil
+}
+
+// scanLineError menentukan kenapa scan ditolak: barcode tidak dikenal,
+// variant tidak ada di dokumen, atau baris sudah ter-scan penuh
+func (r *TransactionRepositoryImpl) scanLineError(ctx context.Context, id uint, barcode string) error {
+	var known, onDocument bool
+	err := conn(ctx, r.db).QueryRowContext(ctx, `
+		SELECT
+			EXISTS (SELECT 1 FROM product_detail WHERE barcode = $2),
+			EXISTS (
+				SELECT 1
+				FROM detail_transactions dt
+				JOIN product_detail pd ON pd.id = dt.id_detail_product
+				WHERE dt.id_transaction = $1 AND pd.barcode = $2
+			)`,
+		id, barcode,
+	).Scan(&known, &onDocument)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on scanLineError in repository layer", "error", err)
+		return err
+	}
+
+	switch {
+	case !known:
+		return ErrProductDetailNotFound
+	case !onDocument:
+		return ErrTransactionItemNotFound
+	default:
+		return ErrScanExceedsQuantity
+	}
+}
+
+// receiveLineError menentukan kenapa baris tidak bisa diterima:
+// variant tidak ada di dokumen, atau jumlahnya melebihi sisa yang belum diterima
+func receiveLineError(ctx context.Context, q dbtx, idTransaction, idDetailProduct uint) error {
+	var exists bool
+	err := q.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM detail_transactions WHERE id_transaction = $1 AND id_detail_product = $2)`,
+		idTransaction, idDetailProduct,
+	).Scan(&exists)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on receiveLineError in repository layer", "error", err)
+		return err
+	}
+
+	if !exists {
+		return ErrTransactionItemNotFound
+	}
+	return ErrReceiveExceedsQuantity
+}
+
+// reserveStock memastikan setiap baris detail tidak melebihi stok tersedia di codeWarehouse.
+// Stok tersedia = quantity di inventory dikurangi quantity dokumen lain yang masih Pending.
+// Baris inventory dikunci (FOR UPDATE) sehingga pembuatan dokumen yang bersamaan
+// untuk variant yang sama dijalankan bergantian dan tidak bisa memesan stok yang sama.
+func reserveStock(ctx context.Context, q dbtx, details []models.DetailTransaction, codeWarehouse string) error {
+	shortage := &StockShortageError{CodeWarehouse: codeWarehouse}
+
+	for i := range details {
+		detail := &details[i]
+
+		err := q.QueryRowContext(ctx,
+			`SELECT code_product, id_size FROM product_detail WHERE id = $1`,
+			detail.IDDetailProduct,
+		).Scan(&detail.ProductDetail.CodeProduct, &detail.ProductDetail.IDSize)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrProductDetailNotFound
+			}
+			slog.ErrorContext(ctx, "error on reserveStock in repository layer when find product detail", "error", err)
+			return err
+		}
+	}
+
+	for _, i := range detailLockOrder(details) {
+		detail := &details[i]
+		codeProduct, idSize := detail.ProductDetail.CodeProduct, detail.ProductDetail.IDSize
+
+		var onHand int
+		err := q.QueryRowContext(ctx, `
+			SELECT
+				quantity
+			FROM
+				inventory
+			WHERE
+				code_product = $1 AND id_size = $2 AND code_warehouse = $3
+			FOR UPDATE`,
+			codeProduct, idSize, codeWarehouse,
+		).Scan(&onHand)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			slog.ErrorContext(ctx, "error on reserveStock in repository layer when lock inventory", "error", err)
+			return err
+		}
+
+		var reserved int
+		err = q.QueryRowContext(ctx, `
+			SELECT
+				COALESCE(SUM(dt.quantity), 0)
+			FROM
+				detail_transactions dt
+			JOIN
+				transactions t ON t.id = dt.id_transaction
+			JOIN
+				product_detail pd ON pd.id = dt.id_detail_product
+			WHERE
+				t.origin_entity_name = $1
+				AND t.id_status = $2
+				AND t.tipe_transaksi = ANY($3)
+				AND pd.code_product = $4
+				AND pd.id_size = $5`,
+			codeWarehouse,
+			models.StatusPending,
+			pq.Array(models.ReservingTransactionTypes),
+			codeProduct,
+			idSize,
+		).Scan(&reserved)
+		if err != nil {
+			slog.ErrorContext(ctx, "error on reserveStock in repository layer when sum reservation", "error", err)
+			return err
+		}
+
+		available := max(onHand-reserved, 0)
+		if detail.Quantity > available {
+			shortage.Lines = append(shortage.Lines, StockShortage{
+				IDDetailProduct: detail.IDDetailProduct,
+				CodeProduct:     codeProduct,
+				IDSize:          idSize,
+				Requested:       detail.Quantity,
+				Available:       available,
+				Shortfall:       detail.Quantity - available,
+			})
+		}
+	}
+
+	if len(shortage.Lines) > 0 {
+		return shortage
+	}
+
+	return nil
+}
+
+// detailLockOrder mengembalikan index detail yang diurutkan sama seperti applyMovements
+// supaya urutan penguncian baris inventory selalu konsisten
+func detailLockOrder(details []models.DetailTransaction) []int {
+	order := make([]int, len(details))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.Slice(order, func(i, j int) bool {
+		a, b := details[order[i]].ProductDetail, details[order[j]].ProductDetail
+		if a.CodeProduct != b.CodeProduct {
+			return a.CodeProduct < b.CodeProduct
+		}
+		return a.IDSize < b.IDSize
+	})
+
+	return order
+}
+
+// insertTransaction menyimpan header dan detail transaksi menggunakan koneksi q
+func insertTransaction(ctx context.Context, q dbtx, trx *models.Transaction) error {
+	query := `
+		INSERT INTO transactions
+			(code_transaksi, origin_entity_name, destination_entity_name, employee_code, id_status, tipe_transaksi)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING
+			id, created_at`
+
+	err := q.QueryRowContext(ctx, query,
+		trx.CodeTransaksi,
+		trx.OriginEntityName,
+		trx.DestinationEntityName,
+		trx.EmployeeCode,
+		trx.IDStatus,
+		trx.TipeTransaksi,
+	).Scan(&trx.ID, &trx.CreatedAt)
+
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) && pgConstraint(err) == "transactions_employee_code_fkey" {
+			return invalidReference(ErrEmployeeNotFound, err)
+		}
+		slog.ErrorContext(ctx, "error on insertTransaction in repository layer when insert header", "error", err)
+		return dbError(err, nil)
+	}
+
+	for i := range trx.Details {
+		detail := &trx.Details[i]
+		detail.IDTransaction = trx.ID
+
+		err := q.QueryRowContext(ctx, `
+			INSERT INTO detail_transactions
+				(id_transaction, id_detail_product, quantity)
+			VALUES
+				($1, $2, $3)
+			RETURNING
+				id`,
+			detail.IDTransaction,
+			detail.IDDetailProduct,
+			detail.Quantity,
+		).Scan(&detail.ID)
+
+		if err != nil {
+			if isPgError(err, pgForeignKeyViolation) {
+				return ErrProductDetailNotFound
+			}
+			slog.ErrorContext(ctx, "error on insertTransaction in repository layer when insert detail", "error", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findDetails membaca baris detail transaksi beserta variant product-nya
+func (r *TransactionRepositoryImpl) findDetails(ctx context.Context, idTransaction uint) ([]models.DetailTransaction, error) {
+	query := `
+		SELECT
+			dt.id, dt.id_transaction, dt.id_detail_product, dt.quantity, dt.scanner_quantity, dt.received_quantity,
+			pd.code_product, pd.id_size, pd.barcode, p.product_name, s.name
+		FROM
+			detail_transactions dt
+		JOIN
+			product_detail pd ON pd.id = dt.id_detail_product
+		JOIN
+			product p ON p.product_code = pd.code_product
+		JOIN
+			size s ON s.id = pd.id_size
+		WHERE
+			dt.id_transaction = $1
+		ORDER BY
+			dt.id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, idTransaction)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on method findDetails transaction in repository layer", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var details []models.DetailTransaction
+	for rows.Next() {
+		var d models.DetailTransaction
+		if err := rows.Scan(
+			&d.ID,
+			&d.IDTransaction,
+			&d.IDDetailProduct,
+			&d.Quantity,
+			&d.ScannerQuantity,
+			&d.ReceivedQuantity,
+			&d.ProductDetail.CodeProduct,
+			&d.ProductDetail.IDSize,
+			&d.ProductDetail.Barcode,
+			&d.ProductDetail.Product.ProductName,
+			&d.ProductDetail.Size.Name,
+		); err != nil {
+			slog.ErrorContext(ctx, "error on method findDetails transaction in repository layer", "error", err)
+			return nil, err
+		}
+
+		d.ProductDetail.ID = d.IDDetailProduct
+		d.ProductDetail.Size.ID = d.ProductDetail.IDSize
+		d.ProductDetail.Product.ProductCode = d.ProductDetail.CodeProduct
+		details = append(details, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+)
+
+// DefaultTxMaxRetries adalah jumlah percobaan ulang default setelah serialization failure
+const DefaultTxMaxRetries = 3
+
+// txRetryBaseDelay adalah jeda sebelum percobaan ulang pertama; jeda berikutnya berlipat dua
+const txRetryBaseDelay = 20 * time.Millisecond
+
+// TxOptions mengatur transaksi yang dibuka TxManager
+type TxOptions struct {
+	// Isolation sql.LevelDefault memakai default database (READ COMMITTED di PostgreSQL)
+	Isolation sql.IsolationLevel
+	ReadOnly  bool
+	// MaxRetries adalah jumlah percobaan ulang fn jika commit atau query gagal dengan
+	// serialization failure (SQLSTATE 40001); 0 berarti tidak diulang
+	MaxRetries int
+}
+
+type TxManagerImpl struct {
+	db       *sql.DB
+	defaults TxOptions
+}
+
+// NewTxManager membuat TxManager untuk repository yang dibuat dari db yang sama.
+// defaults dipakai oleh WithinTx.
+func NewTxManager(db *sql.DB, defaults TxOptions) TxManager {
+	return &TxManagerImpl{db: db, defaults: defaults}
+}
+
+func (m *TxManagerImpl) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.WithinTxOptions(ctx, m.defaults, fn)
+}
+
+func (m *TxManagerImpl) WithinTxOptions(ctx context.Context, opts TxOptions, fn func(ctx context.Context) error) error {
+	// sudah di dalam transaksi: jalankan sebagai savepoint, retry diserahkan ke transaksi terluar
+	if _, ok := txFrom(ctx, m.db); ok {
+		return m.runNested(ctx, fn)
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := m.run(ctx, opts, fn)
+		if err == nil || !isPgError(err, pgSerializationFailure) {
+			return err
+		}
+		if attempt >= opts.MaxRetries {
+			return ErrTxConflict.Wrap(err)
+		}
+
+		delay := txRetryDelay(attempt)
+		slog.WarnContext(ctx, "retrying database transaction after serialization failure",
+			"attempt", attempt+1, "delay", delay, "error", err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(ctx.Err(), err)
+		case <-timer.C:
+		}
+	}
+}
+
+// run menjalankan satu percobaan: begin, fn, lalu commit. Transaksi di-rollback jika fn
+// mengembalikan error atau panic.
+func (m *TxManagerImpl) run(ctx context.Context, opts TxOptions, fn func(ctx context.Context) error) error {
+	tx, err := m.db.BeginTx(ctx, &sql.TxOptions{Isolation: opts.Isolation, ReadOnly: opts.ReadOnly})
+	if err != nil {
+		slog.ErrorContext(ctx, "error on TxManager when begin transaction", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, &txState{db: m.db, tx: tx})); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "error on TxManager when commit", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (m *TxManagerImpl) runNested(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := beginTx(ctx, m.db, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// txRetryDelay adalah exponential backoff dengan jitter supaya transaksi yang
+// bertabrakan tidak langsung bertabrakan lagi
+func txRetryDelay(attempt int) time.Duration {
+	delay := txRetryBaseDelay << attempt
+	return delay/2 + rand.N(delay/2+1)
+}
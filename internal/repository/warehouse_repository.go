@@ -5,9 +5,10 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
 )
 
@@ -22,51 +23,48 @@ func NewWarehouseRepository(db *sql.DB) WarehouseRepository {
 	}
 }
 
-// Implementasi method FindAll
-func (r *warehouseRepositoryImpl) FindAll(ctx context.Context) ([]*models.Warehouse, error) {
-	query := `
-		SELECT 
-			warehouse_name, warehouse_code, location_description 
-		FROM 
-			warehouse limit 30`
-
-	rows, err := r.db.QueryContext(ctx, query)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
+// warehouseList adalah sort dan filter yang didukung FindAll warehouse
+var warehouseList = listSpec{
+	columns:     `warehouse_name, warehouse_code, COALESCE(location_description, '')`,
+	from:        `warehouse`,
+	key:         `id`,
+	defaultSort: "id",
+	sorts: map[string]string{
+		"id":   `id`,
+		"name": `warehouse_name`,
+		"code": `warehouse_code`,
+	},
+	filters: map[string]listFilter{
+		"name":     {column: `warehouse_name`, kind: filterContains},
+		"code":     {column: `warehouse_code`, kind: filterEquals},
+		"location": {column: `location_description`, kind: filterContains},
+	},
+}
 
-	var warehouses []*models.Warehouse
-	for rows.Next() {
+// Implementasi method FindAll
+func (r *warehouseRepositoryImpl) FindAll(ctx context.Context, p pagination.Params) ([]*models.Warehouse, pagination.Page, error) {
+	return findList(ctx, conn(ctx, r.db), "FindAll warehouse", warehouseList, p, func(rows *sql.Rows, extra ...any) (*models.Warehouse, error) {
 		wh := &models.Warehouse{}
-		if err := rows.Scan(
+		err := rows.Scan(append([]any{
 			&wh.WarehouseName,
 			&wh.WarehouseCode,
 			&wh.LocationDescription,
-		); err != nil {
-			return nil, err
-		}
-		warehouses = append(warehouses, wh)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return warehouses, nil
+		}, extra...)...)
+		return wh, err
+	})
 }
 
 // Implementasi method FindById
 func (r *warehouseRepositoryImpl) FindById(ctx context.Context, id string) (*models.Warehouse, error) {
 	query := `
 		SELECT 
-			warehouse_name, warehouse_code, location_description 
+			id, warehouse_name, warehouse_code, COALESCE(location_description, '')
 		FROM 
 			warehouse 
 		WHERE 
 			warehouse_code = $1`
 
-	row := r.db.QueryRowContext(ctx, query, id)
+	row := conn(ctx, r.db).QueryRowContext(ctx, query, id)
 	wh := &models.Warehouse{}
 
 	if err := row.Scan(
@@ -76,9 +74,9 @@ func (r *warehouseRepositoryImpl) FindById(ctx context.Context, id string) (*mod
 		&wh.LocationDescription,
 	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("warehouse not found")
+			return nil, ErrWarehouseNotFound.Wrap(err)
 		}
-		log.Println("error on method FindById in repository layer", err)
+		slog.ErrorContext(ctx, "error on method FindById in repository layer", "error", err)
 		return nil, err
 	}
 
@@ -96,15 +94,15 @@ func (r *warehouseRepositoryImpl) Save(ctx context.Context, warehouse *models.Wa
 			id`
 
 	// Menggunakan QueryRowContext untuk mendapatkan ID yang dikembalikan
-	err := r.db.QueryRowContext(ctx, query,
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
 		warehouse.WarehouseName,
 		warehouse.WarehouseCode,
 		warehouse.LocationDescription,
 	).Scan(&warehouse.ID)
 
 	if err != nil {
-		log.Println("error on method Save in repository layer", err)
-		return err
+		slog.ErrorContext(ctx, "error on method Save in repository layer", "error", err)
+		return dbError(err, nil)
 	}
 
 	return nil
@@ -123,7 +121,7 @@ func (r *warehouseRepositoryImpl) Update(ctx context.Context, warehouse map[stri
 	for column, value := range warehouse {
 		// Validate column
 		if !allowedColumns[column] {
-			return fmt.Errorf("column '%s' is not allowed to be updated", column)
+			return ErrNoFieldsToUpdate.Wrap(fmt.Errorf("column '%s' is not allowed to be updated", column))
 		}
 
 		// Skip nil values
@@ -141,7 +139,7 @@ func (r *warehouseRepositoryImpl) Update(ctx context.Context, warehouse map[stri
 
 	// Check if there are updates
 	if !qb.HasUpdates() {
-		return errors.New("no valid fields to update")
+		return ErrNoFieldsToUpdate
 	}
 
 	// Build final query
@@ -155,9 +153,9 @@ func (r *warehouseRepositoryImpl) Update(ctx context.Context, warehouse map[stri
 	args := append(qb.GetArgs(), code)
 
 	// Execute
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to update warehouse: %w", err)
+		return fmt.Errorf("failed to update warehouse: %w", dbError(err, nil))
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -166,7 +164,7 @@ func (r *warehouseRepositoryImpl) Update(ctx context.Context, warehouse map[stri
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("no rows updated, warehouse not found")
+		return ErrWarehouseNotFound
 	}
 
 	return nil
@@ -176,9 +174,9 @@ func (r *warehouseRepositoryImpl) Update(ctx context.Context, warehouse map[stri
 func (r *warehouseRepositoryImpl) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM warehouse WHERE warehouse_code = $1`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
-		return err
+		return dbError(err, nil)
 	}
 
 	// Cek apakah ada baris yang ter-delete
@@ -187,8 +185,21 @@ func (r *warehouseRepositoryImpl) Delete(ctx context.Context, id string) error {
 		return err
 	}
 	if rowsAffected == 0 {
-		return errors.New("no rows deleted, warehouse not found")
+		return ErrWarehouseNotFound
 	}
 
 	return nil
 }
+
+// Implementasi method ExistsByCode
+func (r *warehouseRepositoryImpl) ExistsByCode(ctx context.Context, code string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM warehouse WHERE warehouse_code = $1)`
+
+	var exists bool
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, code).Scan(&exists); err != nil {
+		slog.ErrorContext(ctx, "error on method ExistsByCode in repository layer", "error", err)
+		return false, err
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,131 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/handler"
+	middleware "github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/middelware"
+	"github.com/gin-gonic/gin"
+)
+
+// Route mendeskripsikan satu endpoint API yang akan didaftarkan ke router
+type Route struct {
+	Method  string
+	Path    string
+	Handler gin.HandlerFunc
+	// Permission yang wajib dimiliki role employee; kosong berarti cukup login
+	Permission auth.Permission
+}
+
+// Handlers menampung semua handler yang dibutuhkan oleh route table
+type Handlers struct {
+	Employee    handler.EmployeeHandler
+	Warehouse   handler.WarehouseHandler
+	Category    handler.CategoryHandler
+	Size        handler.SizeHandler
+	Product     handler.ProductHandler
+	Variant     handler.ProductDetailHandler
+	Inventory   handler.InventoryHandler
+	Transaction handler.TransactionHandler
+	Auth        handler.AuthHandler
+	Role        handler.RoleHandler
+	Audit       handler.AuditHandler
+}
+
+// PublicTable mengembalikan route yang bisa diakses tanpa login (relatif terhadap /api/v1)
+func PublicTable(h Handlers) []Route {
+	return []Route{
+		{http.MethodPost, "/auth/login", h.Auth.HandlerLogin, ""},
+		{http.MethodPost, "/auth/refresh", h.Auth.HandlerRefresh, ""},
+	}
+}
+
+// Table mengembalikan daftar route API yang membutuhkan login (relatif terhadap /api/v1)
+func Table(h Handlers) []Route {
+	return []Route{
+		// auth
+		{http.MethodPost, "/auth/logout", h.Auth.HandlerLogout, ""},
+
+		// employees
+		{http.MethodGet, "/employees", h.Employee.HandlerGetAllEmployee, auth.PermEmployeeRead},
+		{http.MethodPost, "/employees", h.Employee.HandlerCreateEmployee, auth.PermEmployeeWrite},
+		{http.MethodGet, "/employees/by-warehouse/:id", h.Employee.HandlerGetAllEmployeeByWarehouse, auth.PermEmployeeRead},
+		{http.MethodGet, "/employees/:id", h.Employee.HandlerGetEmployee, auth.PermEmployeeRead},
+		{http.MethodPatch, "/employees/:id", h.Employee.HandlerUpdateEmployee, auth.PermEmployeeWrite},
+		{http.MethodDelete, "/employees/:id", h.Employee.HandlerDeleteEmployee, auth.PermEmployeeDelete},
+
+		// warehouses
+		{http.MethodGet, "/warehouses", h.Warehouse.HandlerGetAllWarehouse, auth.PermWarehouseRead},
+		{http.MethodPost, "/warehouses", h.Warehouse.HandlerCreateWarehouse, auth.PermWarehouseWrite},
+		{http.MethodPatch, "/warehouses/:id", h.Warehouse.HandlerUpdateWarehouse, auth.PermWarehouseWrite},
+		{http.MethodDelete, "/warehouses/:id", h.Warehouse.HandlerDeleteWarehouse, auth.PermWarehouseDelete},
+
+		// category
+		{http.MethodGet, "/category", h.Category.HandlerGetAllCategory, auth.PermMasterRead},
+		{http.MethodPost, "/category", h.Category.HandlerCreateCategory, auth.PermMasterWrite},
+		{http.MethodPatch, "/category/:id", h.Category.HandlerUpdateCategory, auth.PermMasterWrite},
+		{http.MethodDelete, "/category/:id", h.Category.HandlerDeleteCategory, auth.PermMasterDelete},
+
+		// size
+		{http.MethodGet, "/size", h.Size.HandlerGetAllSize, auth.PermMasterRead},
+		{http.MethodPost, "/size", h.Size.HandlerCreateSize, auth.PermMasterWrite},
+		{http.MethodPatch, "/size/:id", h.Size.HandlerUpdateSize, auth.PermMasterWrite},
+		{http.MethodDelete, "/size/:id", h.Size.HandlerDeleteSize, auth.PermMasterDelete},
+
+		// products
+		{http.MethodGet, "/products", h.Product.HandlerGetAllProduct, auth.PermProductRead},
+		{http.MethodPost, "/products", h.Product.HandlerCreateProduct, auth.PermProductWrite},
+		{http.MethodGet, "/products/code/:code", h.Product.HandlerGetProductByCode, auth.PermProductRead},
+		{http.MethodGet, "/products/:id", h.Product.HandlerGetProduct, auth.PermProductRead},
+		{http.MethodPatch, "/products/:id", h.Product.HandlerUpdateProduct, auth.PermProductWrite},
+		{http.MethodDelete, "/products/:id", h.Product.HandlerDeleteProduct, auth.PermProductDelete},
+
+		// product variants & barcode
+		{http.MethodGet, "/products/:id/variants", h.Variant.HandlerGetAllVariant, auth.PermProductRead},
+		{http.MethodPost, "/products/:id/variants", h.Variant.HandlerCreateVariant, auth.PermProductWrite},
+		{http.MethodDelete, "/products/:id/variants/:variant_id", h.Variant.HandlerDeleteVariant, auth.PermProductDelete},
+		{http.MethodGet, "/barcodes/:barcode", h.Variant.HandlerLookupBarcode, auth.PermProductRead},
+
+		// inventory
+		{http.MethodGet, "/inventory/warehouses/:code", h.Inventory.HandlerGetStockByWarehouse, auth.PermInventoryRead},
+		{http.MethodGet, "/inventory/products/:code", h.Inventory.HandlerGetStockByProduct, auth.PermInventoryRead},
+		{http.MethodGet, "/inventory/products/:code/sizes/:id_size", h.Inventory.HandlerGetStockByVariant, auth.PermInventoryRead},
+		{http.MethodPost, "/inventory/adjustments", h.Inventory.HandlerAdjustStock, auth.PermInventoryAdjust},
+
+		// transactions
+		{http.MethodPost, "/transactions/inbound", h.Transaction.HandlerCreateInbound, auth.PermTransactionCreate},
+		{http.MethodPost, "/transactions/outbound", h.Transaction.HandlerCreateOutbound, auth.PermTransactionCreate},
+		{http.MethodPost, "/transactions/transfer", h.Transaction.HandlerCreateTransfer, auth.PermTransactionCreate},
+		{http.MethodPost, "/transactions/:code/dispatch", h.Transaction.HandlerDispatchTransfer, auth.PermTransactionDispatch},
+		{http.MethodPost, "/transactions/:code/receive", h.Transaction.HandlerReceiveTransfer, auth.PermTransactionReceive},
+		{http.MethodPost, "/transactions/:code/scan", h.Transaction.HandlerScanItem, auth.PermTransactionScan},
+		{http.MethodPatch, "/transactions/:code/status", h.Transaction.HandlerChangeStatus, auth.PermTransactionStatus},
+		{http.MethodGet, "/transactions/:code", h.Transaction.HandlerGetTransaction, auth.PermTransactionRead},
+		{http.MethodPost, "/transactions/:code/complete", h.Transaction.HandlerCompleteTransaction, auth.PermTransactionComplete},
+
+		// roles & permissions
+		{http.MethodGet, "/roles", h.Role.HandlerGetAllRole, auth.PermRoleRead},
+		{http.MethodPost, "/roles", h.Role.HandlerCreateRole, auth.PermRoleWrite},
+		{http.MethodGet, "/roles/:id", h.Role.HandlerGetRole, auth.PermRoleRead},
+		{http.MethodPatch, "/roles/:id", h.Role.HandlerUpdateRole, auth.PermRoleWrite},
+		{http.MethodPut, "/roles/:id/permissions", h.Role.HandlerSetRolePermissions, auth.PermRoleWrite},
+		{http.MethodDelete, "/roles/:id", h.Role.HandlerDeleteRole, auth.PermRoleDelete},
+		{http.MethodGet, "/permissions", h.Role.HandlerGetAllPermission, auth.PermRoleRead},
+
+		// audit trail
+		{http.MethodGet, "/audit", h.Audit.HandlerGetAllAudit, auth.PermAuditRead},
+	}
+}
+
+// Register mendaftarkan semua route ke router group yang diberikan.
+// checker dipakai untuk route yang membutuhkan permission dan boleh nil jika tidak ada route seperti itu.
+func Register(rg *gin.RouterGroup, routes []Route, checker middleware.PermissionChecker) {
+	for _, r := range routes {
+		if r.Permission == "" {
+			rg.Handle(r.Method, r.Path, r.Handler)
+			continue
+		}
+		rg.Handle(r.Method, r.Path, middleware.Authorize(checker, r.Permission), r.Handler)
+	}
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/logging"
+)
+
+// Jenis entity yang dicatat di audit trail
+const (
+	AuditEmployee      = "employee"
+	AuditWarehouse     = "warehouse"
+	AuditCategory      = "category"
+	AuditSize          = "size"
+	AuditProduct       = "product"
+	AuditProductDetail = "product_detail"
+	AuditInventory     = "inventory"
+	AuditTransaction   = "transaction"
+)
+
+// Aksi yang dicatat di audit trail
+const (
+	AuditCreate = "create"
+	AuditUpdate = "update"
+	AuditDelete = "delete"
+)
+
+type AuditServicesImpl struct {
+	repo repository.AuditRepository
+}
+
+func NewAuditServices(repo repository.AuditRepository) AuditServices {
+	return &AuditServicesImpl{repo: repo}
+}
+
+// Record implements AuditRecorder.
+// Perubahan datanya sudah tersimpan saat Record dipanggil, jadi kegagalan menulis
+// audit hanya dicatat di log dan tidak membatalkan request.
+func (a *AuditServicesImpl) Record(ctx context.Context, entityType, entityKey, action string, before, after any) {
+	if err := a.RecordTx(ctx, entityType, entityKey, action, before, after); err != nil {
+		slog.ErrorContext(ctx, "error on services layer in method Record when save audit log",
+			"entity_type", entityType, "entity_key", entityKey, "action", action, "error", err)
+	}
+}
+
+// RecordTx implements AuditRecorder.
+func (a *AuditServicesImpl) RecordTx(ctx context.Context, entityType, entityKey, action string, before, after any) error {
+	entry := &models.AuditLog{
+		EntityType: entityType,
+		EntityKey:  entityKey,
+		Action:     action,
+		RequestID:  logging.RequestID(ctx),
+	}
+	if claims, ok := auth.ClaimsFromContext(ctx); ok {
+		entry.ActorEmployeeCode = claims.EmployeeCode
+	}
+
+	var err error
+	if entry.Before, err = snapshot(before); err != nil {
+		return err
+	}
+	if entry.After, err = snapshot(after); err != nil {
+		return err
+	}
+	if entry.Diff, err = diffSnapshots(entry.Before, entry.After); err != nil {
+		return err
+	}
+
+	return a.repo.Save(ctx, entry)
+}
+
+// GetAllAudit implements AuditServices.
+func (a *AuditServicesImpl) GetAllAudit(ctx context.Context, p pagination.Params) ([]*response.AuditResponse, *response.Meta, error) {
+	entries, page, err := a.repo.FindAll(ctx, p)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on services layer in method GetAllAudit when get data from repository", "error", err)
+		return nil, nil, err
+	}
+
+	return utils.AuditResponses(entries), utils.PageMeta(page), nil
+}
+
+// snapshot mengubah data menjadi JSON; nil (create atau delete) menjadi snapshot kosong
+func snapshot(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+// diffSnapshots membandingkan field level teratas before dan after, hasilnya
+// {"field": {"before": ..., "after": ...}} untuk setiap field yang berubah
+func diffSnapshots(before, after json.RawMessage) (json.RawMessage, error) {
+	var b, a map[string]json.RawMessage
+	if len(before) > 0 {
+		if err := json.Unmarshal(before, &b); err != nil {
+			return nil, err
+		}
+	}
+	if len(after) > 0 {
+		if err := json.Unmarshal(after, &a); err != nil {
+			return nil, err
+		}
+	}
+
+	type change struct {
+		Before json.RawMessage `json:"before"`
+		After  json.RawMessage `json:"after"`
+	}
+
+	diff := map[string]change{}
+	for field, value := range b {
+		if !bytes.Equal(value, a[field]) {
+			diff[field] = change{Before: value, After: a[field]}
+		}
+	}
+	for field, value := range a {
+		if _, ok := b[field]; !ok {
+			diff[field] = change{After: value}
+		}
+	}
+
+	return json.Marshal(diff)
+}
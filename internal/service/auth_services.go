@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	uuid "github.com/gofrs/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// dummyPasswordHash dipakai saat employee tidak ditemukan supaya waktu respon login
+// sama dengan password yang salah dan tidak bisa dipakai menebak user_id yang terdaftar
+const dummyPasswordHash = "$2a$10$6u8efTS.aJkcJZxH3hskWu6eA7MTtLaIH1GQcru9ZKFeeUq8tP7/y"
+
+type AuthServicesImpl struct {
+	employeeRepo repository.EmployeeRepository
+	repo         repository.AuthRepository
+	tokens       *auth.TokenManager
+}
+
+func NewAuthServices(employeeRepo repository.EmployeeRepository, repo repository.AuthRepository, tokens *auth.TokenManager) AuthServices {
+	return &AuthServicesImpl{
+		employeeRepo: employeeRepo,
+		repo:         repo,
+		tokens:       tokens,
+	}
+}
+
+// Login implements AuthServices.
+func (a *AuthServicesImpl) Login(ctx context.Context, req *request.Login) (*response.TokenResponse, error) {
+	login := req.UserID
+	if login == "" {
+		login = req.EmployeeCode
+	}
+
+	emp, err := a.employeeRepo.FindByLogin(ctx, login)
+	if err != nil {
+		if errors.Is(err, repository.ErrEmployeeNotFound) {
+			_ = bcrypt.CompareHashAndPassword([]byte(dummyPasswordHash), []byte(req.Password))
+			return nil, ErrInvalidCredentials
+		}
+		slog.ErrorContext(ctx, "error on services layer in method Login when find employee", "error", err)
+		return nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(emp.Password), []byte(req.Password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	// setiap login memulai family refresh token baru
+	family, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	res, next, err := a.issueTokens(ctx, emp, family.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.repo.SaveRefreshToken(ctx, next); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Refresh implements AuthServices.
+// Refresh token hanya bisa dipakai sekali. Token lama yang dipakai lagi dianggap bocor,
+// sehingga seluruh family-nya dicabut dan employee harus login ulang.
+func (a *AuthServicesImpl) Refresh(ctx context.Context, req *request.RefreshToken) (*response.TokenResponse, error) {
+	old, err := a.repo.FindRefreshToken(ctx, auth.HashRefreshToken(req.RefreshToken))
+	if err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	if old.RevokedAt != nil {
+		return nil, a.revokeFamily(ctx, old.FamilyID)
+	}
+
+	if time.Now().After(old.ExpiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	// role dan warehouse dibaca ulang supaya perubahan data employee langsung berlaku
+	emp, err := a.employeeRepo.FindByLogin(ctx, old.EmployeeCode)
+	if err != nil {
+		if errors.Is(err, repository.ErrEmployeeNotFound) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	res, next, err := a.issueTokens(ctx, emp, old.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.repo.RotateRefreshToken(ctx, old.ID, next); err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenRevoked) {
+			// token yang sama sudah dirotasi oleh request lain
+			return nil, a.revokeFamily(ctx, old.FamilyID)
+		}
+		slog.ErrorContext(ctx, "error on services layer in method Refresh when rotate refresh token", "error", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Logout implements AuthServices.
+// Access token yang sedang dipakai masuk daftar revoke; jika refresh token dikirim,
+// seluruh family-nya ikut dicabut.
+func (a *AuthServicesImpl) Logout(ctx context.Context, claims *auth.Claims, req *request.Logout) error {
+	if err := a.repo.RevokeAccessToken(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		return err
+	}
+
+	if req.RefreshToken == "" {
+		return nil
+	}
+
+	token, err := a.repo.FindRefreshToken(ctx, auth.HashRefreshToken(req.RefreshToken))
+	if err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	// refresh token milik employee lain tidak boleh dicabut lewat logout
+	if token.EmployeeCode != claims.EmployeeCode {
+		return nil
+	}
+
+	return a.repo.RevokeRefreshFamily(ctx, token.FamilyID)
+}
+
+// IsTokenRevoked implements AuthServices.
+func (a *AuthServicesImpl) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	return a.repo.IsAccessTokenRevoked(ctx, jti)
+}
+
+// issueTokens membuat pasangan access token dan refresh token baru dalam family yang sama
+func (a *AuthServicesImpl) issueTokens(ctx context.Context, emp *models.Employee, familyID string) (*response.TokenResponse, *models.RefreshToken, error) {
+	accessToken, _, err := a.tokens.IssueAccessToken(emp)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on services layer when sign access token", "error", err)
+		return nil, nil, err
+	}
+
+	refreshToken, hash, err := auth.NewRefreshToken()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next := &models.RefreshToken{
+		TokenHash:    hash,
+		EmployeeCode: emp.EmployeeCode,
+		FamilyID:     familyID,
+		ExpiresAt:    time.Now().Add(a.tokens.RefreshTTL()),
+	}
+
+	res := &response.TokenResponse{
+		AccessToken:      accessToken,
+		TokenType:        "Bearer",
+		ExpiresIn:        int(a.tokens.AccessTTL().Seconds()),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: next.ExpiresAt,
+		EmployeeCode:     emp.EmployeeCode,
+		Role:             emp.Role.RoleName,
+		WarehouseCode:    emp.WarehouseCode,
+	}
+
+	return res, next, nil
+}
+
+// revokeFamily mencabut seluruh refresh token satu sesi login lalu mengembalikan ErrInvalidRefreshToken
+func (a *AuthServicesImpl) revokeFamily(ctx context.Context, familyID string) error {
+	if err := a.repo.RevokeRefreshFamily(ctx, familyID); err != nil {
+		return err
+	}
+	return ErrInvalidRefreshToken
+}
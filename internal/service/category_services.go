@@ -2,17 +2,20 @@ package service
 
 import (
 	"context"
-	"log"
+	"log/slog"
+	"strconv"
 
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
 )
 
 type CategoryServicesImpl struct {
-	repo repository.CategoryRepository
+	repo  repository.CategoryRepository
+	audit AuditRecorder
 }
 
 // CreateCategory implements CategoryServices.
@@ -21,50 +24,63 @@ func (c *CategoryServicesImpl) CreateCategory(ctx context.Context, category *req
 	err := c.repo.Save(ctx, model)
 
 	if err != nil {
-		log.Println("error on layer services in CreateCategory when save category", err)
+		slog.ErrorContext(ctx, "error on layer services in CreateCategory when save category", "error", err)
 		return err
 	}
 
+	c.audit.Record(ctx, AuditCategory, strconv.Itoa(int(model.ID)), AuditCreate, nil, utils.CategeryResponse(model))
 	return nil
 }
 
 // DeleteCategory implements CategoryServices.
 func (c *CategoryServicesImpl) DeleteCategory(ctx context.Context, id int) error {
-	err := c.repo.Delete(ctx, id)
+	existing, err := c.repo.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = c.repo.Delete(ctx, id)
 
 	if err != nil {
-		log.Println("error on layer services in DeleteCategory when delete category", err)
+		slog.ErrorContext(ctx, "error on layer services in DeleteCategory when delete category", "error", err)
 		return err
 	}
 
+	c.audit.Record(ctx, AuditCategory, strconv.Itoa(id), AuditDelete, utils.CategeryResponse(existing), nil)
 	return nil
 }
 
 // GetAllCategory implements CategoryServices.
-func (c *CategoryServicesImpl) GetAllCategory(ctx context.Context) ([]*response.CategoryResponses, error) {
-	models, err := c.repo.FindAll(ctx)
+func (c *CategoryServicesImpl) GetAllCategory(ctx context.Context, p pagination.Params) ([]*response.CategoryResponses, *response.Meta, error) {
+	models, page, err := c.repo.FindAll(ctx, p)
 
 	if err != nil {
-		log.Println("error on layer services in GetAllCategory when get all category", err)
-		return nil, err
+		slog.ErrorContext(ctx, "error on layer services in GetAllCategory when get all category", "error", err)
+		return nil, nil, err
 	}
 
-	return utils.CategeryReponses(models), nil
+	return utils.CategeryReponses(models), utils.PageMeta(page), nil
 }
 
 // UpdateCategory implements CategoryServices.
 func (c *CategoryServicesImpl) UpdateCategory(ctx context.Context, category *request.UpdatedCategory, id int) error {
+	existing, err := c.repo.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	model := &models.Category{Name: category.Name, ID: uint(id)}
-	err := c.repo.Update(ctx, model)
+	err = c.repo.Update(ctx, model)
 
 	if err != nil {
-		log.Println("error on layer services in UpdateCategory when update category", err)
+		slog.ErrorContext(ctx, "error on layer services in UpdateCategory when update category", "error", err)
 		return err
 	}
 
+	c.audit.Record(ctx, AuditCategory, strconv.Itoa(id), AuditUpdate, utils.CategeryResponse(existing), utils.CategeryResponse(model))
 	return nil
 }
 
-func NewCategoryServices(repo repository.CategoryRepository) CategoryServices {
-	return &CategoryServicesImpl{repo: repo}
+func NewCategoryServices(repo repository.CategoryRepository, audit AuditRecorder) CategoryServices {
+	return &CategoryServicesImpl{repo: repo, audit: audit}
 }
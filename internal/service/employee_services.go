@@ -4,29 +4,57 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
-	"github.com/go-playground/validator/v10"
 	uuid "github.com/gofrs/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	// BootstrapAdminCode adalah super admin dari data awal migration 000003
+	BootstrapAdminCode = "SA-001"
+	// password SA-001 dari migration 000003, bukan hash bcrypt sehingga tidak bisa login
+	bootstrapPlaceholderPassword = "ganti_dengan_password_hash"
+)
+
 type EmployeeServicesImpl struct {
 	EmployeeRepository repository.EmployeeRepository
-	validate           *validator.Validate
+	roles              RoleAssigner
+	audit              AuditRecorder
 }
 
-func (s *EmployeeServicesImpl) CreateEmployee(ctx context.Context, req *request.CreateEmployee) error {
-	err := s.validate.Struct(req)
+// ensureRoleAssignable memastikan user yang login boleh memberi idRole ke employee
+func (s *EmployeeServicesImpl) ensureRoleAssignable(ctx context.Context, idRole uint) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
 
+	allowed, err := s.roles.CanAssignRole(ctx, claims, idRole)
 	if err != nil {
 		return err
 	}
+	if !allowed {
+		return ErrRoleAssignmentForbidden
+	}
+	return nil
+}
+
+func (s *EmployeeServicesImpl) CreateEmployee(ctx context.Context, req *request.CreateEmployee) error {
+	// employee hanya boleh dibuat untuk warehouse yang boleh diakses
+	if err := ensureWarehouseScope(ctx, req.WarehouseCode); err != nil {
+		return err
+	}
+	if err := s.ensureRoleAssignable(ctx, uint(req.IDRole)); err != nil {
+		return err
+	}
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
@@ -63,14 +91,15 @@ func (s *EmployeeServicesImpl) CreateEmployee(ctx context.Context, req *request.
 		return err
 	}
 
-	// 6. Kembalikan model yang sudah lengkap
+	// 6. Catat ke audit trail (password tidak ikut tercatat)
+	s.audit.Record(ctx, AuditEmployee, employeeModel.EmployeeCode, AuditCreate, nil, utils.EmployeeResponse(employeeModel))
 	return nil
 }
 
 func (s *EmployeeServicesImpl) UpdateEmployee(ctx context.Context, employee_code string, req *request.UpdatedEmployee) error {
 	// 1. Validasi input ID
 	if employee_code == "" {
-		return errors.New("employee ID is required")
+		return ErrEmployeeCodeRequired
 	}
 
 	// 2. READ - Ambil data existing employee
@@ -79,6 +108,21 @@ func (s *EmployeeServicesImpl) UpdateEmployee(ctx context.Context, employee_code
 		return fmt.Errorf("failed to find employee: %w", err)
 	}
 
+	if err := ensureWarehouseScope(ctx, existingEmployee.WarehouseCode); err != nil {
+		return err
+	}
+	// employee dengan role yang lebih tinggi (misalnya password super admin) tidak
+	// boleh diubah, begitu juga menaikkan role ke role yang lebih tinggi
+	if err := s.ensureRoleAssignable(ctx, existingEmployee.IDRole); err != nil {
+		return err
+	}
+	if req.IDRole != 0 && uint(req.IDRole) != existingEmployee.IDRole {
+		if err := s.ensureRoleAssignable(ctx, uint(req.IDRole)); err != nil {
+			return err
+		}
+	}
+	before := utils.EmployeeResponse(existingEmployee)
+
 	// 3. MODIFY - Update field yang dikirim (selective update)
 	// Perbaikan: Cek pointer dengan benar untuk optional fields
 	if req.EmployeeName != "" {
@@ -92,11 +136,6 @@ func (s *EmployeeServicesImpl) UpdateEmployee(ctx context.Context, employee_code
 
 	// 4. Handle password update dengan validasi
 	if req.Password != nil && *req.Password != "" {
-		// Validasi password minimal length (contoh: min 8 karakter)
-		if len(*req.Password) < 8 {
-			return errors.New("password must be at least 8 characters")
-		}
-
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(*req.Password), bcrypt.DefaultCost)
 		if err != nil {
 			return fmt.Errorf("failed to hash password: %w", err)
@@ -112,40 +151,64 @@ func (s *EmployeeServicesImpl) UpdateEmployee(ctx context.Context, employee_code
 		return fmt.Errorf("failed to update employee: %w", err)
 	}
 
+	s.audit.Record(ctx, AuditEmployee, employee_code, AuditUpdate, before, utils.EmployeeResponse(existingEmployee))
 	return nil
 }
 
-func (s *EmployeeServicesImpl) GetAllEmployee(ctx context.Context) ([]*response.EmployeeResponse, error) {
-	models, err := s.EmployeeRepository.FindAll(ctx)
+func (s *EmployeeServicesImpl) GetAllEmployee(ctx context.Context, p pagination.Params) ([]*response.EmployeeResponse, *response.Meta, error) {
+	// role di bawah admin hanya melihat employee di warehouse-nya sendiri
+	if scope := auth.ScopeFrom(ctx); !scope.All {
+		return s.GetAllEmployeeByWarehouse(ctx, scope.WarehouseCode, p)
+	}
+
+	models, page, err := s.EmployeeRepository.FindAll(ctx, p)
 
 	if err != nil {
-		log.Println("error on services layer in method GetAllEmployee when get data from repository", err)
-		return nil, err
+		slog.ErrorContext(ctx, "error on services layer in method GetAllEmployee when get data from repository", "error", err)
+		return nil, nil, err
 	}
 
 	resp := utils.EmployeeReponses(models)
 
-	return resp, nil
+	return resp, utils.PageMeta(page), nil
 }
 
-func (s *EmployeeServicesImpl) GetAllEmployeeByWarehouse(ctx context.Context, warehouseCode string) ([]*response.EmployeeResponse, error) {
-	models, err := s.EmployeeRepository.FindAllByWarehouse(ctx, warehouseCode)
+func (s *EmployeeServicesImpl) GetAllEmployeeByWarehouse(ctx context.Context, warehouseCode string, p pagination.Params) ([]*response.EmployeeResponse, *response.Meta, error) {
+	if err := ensureWarehouseScope(ctx, warehouseCode); err != nil {
+		return nil, nil, err
+	}
+
+	// filter warehouse_code dari client tidak boleh keluar dari warehouse yang diminta
+	if code, ok := p.Filters["warehouse_code"]; ok && code != warehouseCode {
+		return nil, nil, ErrWarehouseForbidden
+	}
+	filters := map[string]string{"warehouse_code": warehouseCode}
+	for key, value := range p.Filters {
+		filters[key] = value
+	}
+	p.Filters = filters
+
+	models, page, err := s.EmployeeRepository.FindAll(ctx, p)
 
 	if err != nil {
-		log.Println("error on services layer in method GetAllEmployeeByWarehouse when get data from repository", err)
-		return nil, err
+		slog.ErrorContext(ctx, "error on services layer in method GetAllEmployeeByWarehouse when get data from repository", "error", err)
+		return nil, nil, err
 	}
 
 	resp := utils.EmployeeReponses(models)
 
-	return resp, nil
+	return resp, utils.PageMeta(page), nil
 }
 
 func (s *EmployeeServicesImpl) GetEmployeeById(ctx context.Context, id string) (*response.EmployeeResponse, error) {
 	models, err := s.EmployeeRepository.FindById(ctx, id)
 
 	if err != nil {
-		log.Println("error on services layer in method GetEmployeeById when get data from repository", err)
+		slog.ErrorContext(ctx, "error on services layer in method GetEmployeeById when get data from repository", "error", err)
+		return nil, err
+	}
+
+	if err := ensureWarehouseScope(ctx, models.WarehouseCode); err != nil {
 		return nil, err
 	}
 
@@ -155,13 +218,57 @@ func (s *EmployeeServicesImpl) GetEmployeeById(ctx context.Context, id string) (
 }
 
 func (s *EmployeeServicesImpl) DeleteEmployee(ctx context.Context, id string) error {
-	// Service tidak perlu logic tambahan, langsung panggil repo
-	return s.EmployeeRepository.Delete(ctx, id)
+	existingEmployee, err := s.EmployeeRepository.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := ensureWarehouseScope(ctx, existingEmployee.WarehouseCode); err != nil {
+		return err
+	}
+	// employee dengan role yang lebih tinggi (misalnya super admin) tidak boleh dihapus
+	if err := s.ensureRoleAssignable(ctx, existingEmployee.IDRole); err != nil {
+		return err
+	}
+
+	if err := s.EmployeeRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, AuditEmployee, id, AuditDelete, utils.EmployeeResponse(existingEmployee), nil)
+	return nil
+}
+
+// BootstrapAdminPassword implements EmployeeServices. Password SA-001 hanya diganti
+// selama masih placeholder, jadi password yang sudah diganti lewat API tidak tertimpa
+// saat restart. set bernilai false jika password sudah pernah dipasang.
+func (s *EmployeeServicesImpl) BootstrapAdminPassword(ctx context.Context, password string) (set bool, err error) {
+	admin, err := s.EmployeeRepository.FindById(ctx, BootstrapAdminCode)
+	if err != nil {
+		return false, err
+	}
+	if admin.Password != bootstrapPlaceholderPassword {
+		return false, nil
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return false, fmt.Errorf("failed to hash password: %w", err)
+	}
+	admin.Password = string(hashedPassword)
+
+	if err := s.EmployeeRepository.Update(ctx, admin); err != nil {
+		return false, fmt.Errorf("failed to update employee: %w", err)
+	}
+
+	s.audit.Record(ctx, AuditEmployee, BootstrapAdminCode, AuditUpdate, nil, utils.EmployeeResponse(admin))
+	return true, nil
 }
 
-func NewEmployeeServices(employeeRepository repository.EmployeeRepository, validate *validator.Validate) EmployeeServices {
+func NewEmployeeServices(employeeRepository repository.EmployeeRepository, roles RoleAssigner, audit AuditRecorder) EmployeeServices {
 	return &EmployeeServicesImpl{
 		EmployeeRepository: employeeRepository,
-		validate:           validate,
+		roles:              roles,
+		audit:              audit,
 	}
 }
@@ -0,0 +1,28 @@
+package service
+
+import "github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+
+// Error bisnis dari layer service yang bisa dicek dengan errors.Is
+var (
+	ErrInvalidCredentials  = apperror.Unauthorized("invalid_credentials", "invalid user_id/employee_code or password")
+	ErrInvalidRefreshToken = apperror.Unauthorized("invalid_refresh_token", "invalid, expired or revoked refresh token")
+	ErrUnauthenticated     = apperror.Unauthorized("unauthenticated", "login is required")
+	ErrWarehouseForbidden  = apperror.Forbidden("warehouse_forbidden", "access to this warehouse is not allowed")
+
+	ErrUnknownPermission = apperror.Validation("unknown_permission", "unknown permission")
+	ErrRoleProtected     = apperror.Conflict("role_protected", "super admin role cannot be renamed or deleted")
+
+	ErrRoleAssignmentForbidden  = apperror.Forbidden("role_assignment_forbidden", "cannot assign a role with permissions you do not have")
+	ErrPermissionGrantForbidden = apperror.Forbidden("permission_grant_forbidden", "cannot grant permissions you do not have")
+
+	ErrIllegalStatusTransition    = apperror.Conflict("illegal_status_transition", "transaction status cannot be changed to the requested status")
+	ErrUnknownStatus              = apperror.Validation("unknown_status", "unknown transaction status")
+	ErrTransactionNotInTransit    = apperror.Conflict("transaction_not_in_transit", "transaction is not in transit")
+	ErrUnknownTransactionType     = apperror.Validation("unknown_transaction_type", "unknown transaction type")
+	ErrTransactionClosed          = apperror.Conflict("transaction_closed", "transaction is already closed")
+	ErrNotTransfer                = apperror.Validation("not_transfer", "transaction is not a transfer")
+	ErrTransferCompletedByReceive = apperror.Conflict("transfer_completed_by_receive", "transfer is completed by receiving its items through POST /transactions/{code}/receive")
+	ErrTransferSentByDispatch     = apperror.Conflict("transfer_sent_by_dispatch", "transfer is moved to In Transit through POST /transactions/{code}/dispatch")
+
+	ErrEmployeeCodeRequired = apperror.Validation("employee_code_required", "employee ID is required")
+)
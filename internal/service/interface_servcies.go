@@ -3,37 +3,115 @@ package service
 import (
 	"context"
 
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
 )
 
 type EmployeeServices interface {
-	GetAllEmployee(ctx context.Context) ([]*response.EmployeeResponse, error)
-	GetAllEmployeeByWarehouse(ctx context.Context, warehouseCode string) ([]*response.EmployeeResponse, error)
+	GetAllEmployee(ctx context.Context, p pagination.Params) ([]*response.EmployeeResponse, *response.Meta, error)
+	GetAllEmployeeByWarehouse(ctx context.Context, warehouseCode string, p pagination.Params) ([]*response.EmployeeResponse, *response.Meta, error)
 	GetEmployeeById(ctx context.Context, id string) (*response.EmployeeResponse, error)
 	CreateEmployee(ctx context.Context, employee *request.CreateEmployee) error
 	UpdateEmployee(ctx context.Context, id string, req *request.UpdatedEmployee) error
 	DeleteEmployee(ctx context.Context, id string) error
+	// BootstrapAdminPassword memasang password super admin awal, lihat BootstrapAdminCode
+	BootstrapAdminPassword(ctx context.Context, password string) (bool, error)
 }
 
 type WarehouseServices interface {
-	GetAllWarehouse(ctx context.Context) ([]*response.WarehouseResponse, error)
+	GetAllWarehouse(ctx context.Context, p pagination.Params) ([]*response.WarehouseResponse, *response.Meta, error)
 	GetWarehouseById(ctx context.Context, id string) (*response.WarehouseResponse, error)
 	CreateWarehouse(ctx context.Context, warehouse *request.CreateWarehouse) error
-	UpdateWarehouse(ctx context.Context, warehouse *request.UpdateWarehouse) error
+	UpdateWarehouse(ctx context.Context, code string, warehouse *request.UpdateWarehouse) error
 	DeleteWarehouse(ctx context.Context, id string) error
 }
 
 type CategoryServices interface {
-	GetAllCategory(ctx context.Context) ([]*response.CategoryResponses, error)
+	GetAllCategory(ctx context.Context, p pagination.Params) ([]*response.CategoryResponses, *response.Meta, error)
 	CreateCategory(ctx context.Context, category *request.CreateCategory) error
 	UpdateCategory(ctx context.Context, category *request.UpdatedCategory, id int) error
 	DeleteCategory(ctx context.Context, id int) error
 }
 
 type SizeServices interface {
-	GetAllSize(ctx context.Context) ([]*response.SizeResponse, error)
+	GetAllSize(ctx context.Context, p pagination.Params) ([]*response.SizeResponse, *response.Meta, error)
 	SaveSize(ctx context.Context, size *request.CreateSize) error
 	UpdateSize(ctx context.Context, size *request.UpdatedSize, id int) error
 	DeleteSize(ctx context.Context, id int) error
 }
+
+type ProductServices interface {
+	GetAllProduct(ctx context.Context, p pagination.Params) ([]*response.ProductResponse, *response.Meta, error)
+	GetProductById(ctx context.Context, id int) (*response.ProductResponse, error)
+	GetProductByCode(ctx context.Context, code string) (*response.ProductResponse, error)
+	CreateProduct(ctx context.Context, product *request.CreateProduct) (*response.ProductResponse, error)
+	UpdateProduct(ctx context.Context, id int, product *request.UpdateProduct) error
+	DeleteProduct(ctx context.Context, id int) error
+}
+
+type ProductDetailServices interface {
+	GetAllVariant(ctx context.Context, productID int) ([]*response.ProductDetailResponse, error)
+	CreateVariant(ctx context.Context, productID int, req *request.CreateProductDetail) (*response.ProductDetailResponse, error)
+	DeleteVariant(ctx context.Context, productID int, variantID int) error
+	LookupBarcode(ctx context.Context, barcode string) (*response.BarcodeLookupResponse, error)
+}
+
+type InventoryServices interface {
+	GetStockByWarehouse(ctx context.Context, codeWarehouse string) ([]*response.InventoryResponse, error)
+	GetStockByProduct(ctx context.Context, codeProduct string) ([]*response.InventoryResponse, error)
+	GetStockByVariant(ctx context.Context, codeProduct string, idSize int) ([]*response.InventoryResponse, error)
+	AdjustStock(ctx context.Context, req *request.AdjustInventory) (*response.InventoryResponse, error)
+}
+
+type TransactionServices interface {
+	GetTransaction(ctx context.Context, code string) (*response.TransactionResponse, error)
+	CreateInbound(ctx context.Context, req *request.CreateInboundTransaction) (*response.TransactionResponse, error)
+	CreateOutbound(ctx context.Context, req *request.CreateOutboundTransaction) (*response.TransactionResponse, error)
+	CreateTransfer(ctx context.Context, req *request.CreateTransferTransaction) (*response.TransactionResponse, error)
+	DispatchTransfer(ctx context.Context, code string) (*response.TransactionResponse, error)
+	ReceiveTransfer(ctx context.Context, code string, req *request.ReceiveTransfer) (*response.TransactionResponse, error)
+	CompleteTransaction(ctx context.Context, code string) (*response.TransactionResponse, error)
+	ChangeStatus(ctx context.Context, code string, req *request.ChangeTransactionStatus) (*response.TransactionResponse, error)
+	ScanItem(ctx context.Context, code string, req *request.ScanItem) (*response.ScanProgressResponse, error)
+}
+
+type AuthServices interface {
+	Login(ctx context.Context, req *request.Login) (*response.TokenResponse, error)
+	Refresh(ctx context.Context, req *request.RefreshToken) (*response.TokenResponse, error)
+	Logout(ctx context.Context, claims *auth.Claims, req *request.Logout) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+type RoleServices interface {
+	GetAllRole(ctx context.Context, p pagination.Params) ([]*response.RoleResponse, *response.Meta, error)
+	GetRoleById(ctx context.Context, id int) (*response.RoleResponse, error)
+	CreateRole(ctx context.Context, req *request.CreateRole) (*response.RoleResponse, error)
+	UpdateRole(ctx context.Context, id int, req *request.UpdateRole) (*response.RoleResponse, error)
+	SetPermissions(ctx context.Context, id int, req *request.SetRolePermissions) (*response.RoleResponse, error)
+	DeleteRole(ctx context.Context, id int) error
+	GetAllPermission(ctx context.Context) []string
+	HasPermission(ctx context.Context, claims *auth.Claims, permission auth.Permission) (bool, error)
+	RoleAssigner
+}
+
+// RoleAssigner dipakai EmployeeServices sebelum memberi role ke employee, supaya
+// pemanggil tidak bisa memberi role dengan permission yang tidak ia miliki.
+type RoleAssigner interface {
+	CanAssignRole(ctx context.Context, claims *auth.Claims, idRole uint) (bool, error)
+}
+
+// AuditRecorder dipanggil service setelah create/update/delete berhasil. before dan after
+// adalah snapshot data (nil untuk create dan delete) yang disimpan sebagai JSON.
+type AuditRecorder interface {
+	Record(ctx context.Context, entityType, entityKey, action string, before, after any)
+	// RecordTx menulis audit lewat transaksi yang dibawa ctx (TxManager) dan mengembalikan
+	// error-nya, sehingga perubahan data dan audit-nya tersimpan atau batal bersama
+	RecordTx(ctx context.Context, entityType, entityKey, action string, before, after any) error
+}
+
+type AuditServices interface {
+	AuditRecorder
+	GetAllAudit(ctx context.Context, p pagination.Params) ([]*response.AuditResponse, *response.Meta, error)
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
+)
+
+type InventoryServicesImpl struct {
+	repo       repository.InventoryRepository
+	detailRepo repository.ProductDetailRepository
+	audit      AuditRecorder
+	tx         repository.TxManager
+}
+
+// NewInventoryServices membuat InventoryServices. Penyesuaian stok dan audit-nya
+// ditulis dalam satu transaksi tx.
+func NewInventoryServices(repo repository.InventoryRepository, detailRepo repository.ProductDetailRepository, audit AuditRecorder, tx repository.TxManager) InventoryServices {
+	return &InventoryServicesImpl{
+		repo:       repo,
+		detailRepo: detailRepo,
+		audit:      audit,
+		tx:         tx,
+	}
+}
+
+// GetStockByWarehouse implements InventoryServices.
+func (i *InventoryServicesImpl) GetStockByWarehouse(ctx context.Context, codeWarehouse string) ([]*response.InventoryResponse, error) {
+	if err := ensureWarehouseScope(ctx, codeWarehouse); err != nil {
+		return nil, err
+	}
+
+	inventories, err := i.repo.FindAllByWarehouse(ctx, codeWarehouse)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on services layer in method GetStockByWarehouse when get data from repository", "error", err)
+		return nil, err
+	}
+
+	return utils.InventoryResponses(inventories), nil
+}
+
+// GetStockByProduct implements InventoryServices.
+func (i *InventoryServicesImpl) GetStockByProduct(ctx context.Context, codeProduct string) ([]*response.InventoryResponse, error) {
+	inventories, err := i.repo.FindAllByProduct(ctx, codeProduct)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on services layer in method GetStockByProduct when get data from repository", "error", err)
+		return nil, err
+	}
+
+	return utils.InventoryResponses(scopedInventories(ctx, inventories)), nil
+}
+
+// GetStockByVariant implements InventoryServices.
+func (i *InventoryServicesImpl) GetStockByVariant(ctx context.Context, codeProduct string, idSize int) ([]*response.InventoryResponse, error) {
+	inventories, err := i.repo.FindAllByVariant(ctx, codeProduct, idSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on services layer in method GetStockByVariant when get data from repository", "error", err)
+		return nil, err
+	}
+
+	return utils.InventoryResponses(scopedInventories(ctx, inventories)), nil
+}
+
+// AdjustStock implements InventoryServices.
+func (i *InventoryServicesImpl) AdjustStock(ctx context.Context, req *request.AdjustInventory) (*response.InventoryResponse, error) {
+	if err := ensureWarehouseScope(ctx, req.CodeWarehouse); err != nil {
+		return nil, err
+	}
+
+	return withinTx(ctx, i.tx, func(ctx context.Context) (*response.InventoryResponse, error) {
+		// stok hanya boleh dicatat untuk variant (product + size) yang terdaftar
+		exists, err := i.detailRepo.ExistsByProductAndSize(ctx, req.CodeProduct, req.IDSize)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, repository.ErrProductDetailNotFound
+		}
+
+		inventory, err := i.repo.Adjust(ctx, req.CodeProduct, req.IDSize, req.CodeWarehouse, req.Quantity)
+		if err != nil {
+			slog.ErrorContext(ctx, "error on services layer in method AdjustStock when adjust inventory", "error", err)
+			return nil, err
+		}
+
+		// Adjust mengubah stok secara atomik, jadi kondisi sebelumnya adalah stok sesudah dikurangi delta
+		after := utils.InventoryResponse(inventory)
+		before := *after
+		before.Quantity -= req.Quantity
+		key := fmt.Sprintf("%s/%d/%s", req.CodeProduct, req.IDSize, req.CodeWarehouse)
+		if err := i.audit.RecordTx(ctx, AuditInventory, key, AuditUpdate, &before, after); err != nil {
+			return nil, err
+		}
+
+		return after, nil
+	})
+}
+
+// scopedInventories membuang stok warehouse yang tidak boleh diakses request
+func scopedInventories(ctx context.Context, inventories []*models.Inventory) []*models.Inventory {
+	scope := auth.ScopeFrom(ctx)
+	if scope.All {
+		return inventories
+	}
+
+	var scoped []*models.Inventory
+	for _, inv := range inventories {
+		if scope.Allows(inv.CodeWarehouse) {
+			scoped = append(scoped, inv)
+		}
+	}
+	return scoped
+}
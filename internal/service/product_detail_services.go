@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
+)
+
+// jumlah percobaan generate ulang jika barcode otomatis kebetulan sudah dipakai
+const barcodeGenerateAttempts = 3
+
+type ProductDetailServicesImpl struct {
+	repo        repository.ProductDetailRepository
+	productRepo repository.ProductRepository
+	audit       AuditRecorder
+	tx          repository.TxManager
+}
+
+// NewProductDetailServices membuat ProductDetailServices. Variant dan audit-nya
+// ditulis dalam satu transaksi tx.
+func NewProductDetailServices(repo repository.ProductDetailRepository, productRepo repository.ProductRepository, audit AuditRecorder, tx repository.TxManager) ProductDetailServices {
+	return &ProductDetailServicesImpl{
+		repo:        repo,
+		productRepo: productRepo,
+		audit:       audit,
+		tx:          tx,
+	}
+}
+
+// GetAllVariant implements ProductDetailServices.
+func (p *ProductDetailServicesImpl) GetAllVariant(ctx context.Context, productID int) ([]*response.ProductDetailResponse, error) {
+	product, err := p.productRepo.FindById(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := p.repo.FindAllByProduct(ctx, product.ProductCode)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on services layer in method GetAllVariant when get data from repository", "error", err)
+		return nil, err
+	}
+
+	return utils.ProductDetailResponses(details), nil
+}
+
+// CreateVariant implements ProductDetailServices.
+func (p *ProductDetailServicesImpl) CreateVariant(ctx context.Context, productID int, req *request.CreateProductDetail) (*response.ProductDetailResponse, error) {
+	return withinTx(ctx, p.tx, func(ctx context.Context) (*response.ProductDetailResponse, error) {
+		product, err := p.productRepo.FindById(ctx, productID)
+		if err != nil {
+			return nil, err
+		}
+
+		// 1. Tolak duplikat (code_product, id_size) lebih awal dengan error yang jelas
+		exists, err := p.repo.ExistsByProductAndSize(ctx, product.ProductCode, req.IDSize)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, repository.ErrProductDetailExists
+		}
+
+		detail := &models.ProductDetail{
+			CodeProduct: product.ProductCode,
+			IDSize:      uint(req.IDSize),
+		}
+
+		// 2. Barcode dari client sudah divalidasi saat bind, jika kosong dibuat otomatis
+		if req.Barcode != "" {
+			detail.Barcode = req.Barcode
+			if err := p.repo.Save(ctx, detail); err != nil {
+				slog.ErrorContext(ctx, "error on services layer in method CreateVariant when save product detail", "error", err)
+				return nil, err
+			}
+		} else {
+			if err := p.saveWithGeneratedBarcode(ctx, detail, req.BarcodeType); err != nil {
+				slog.ErrorContext(ctx, "error on services layer in method CreateVariant when save product detail", "error", err)
+				return nil, err
+			}
+		}
+
+		created, err := p.repo.FindById(ctx, int(detail.ID))
+		if err != nil {
+			return nil, err
+		}
+
+		resp := utils.ProductDetailResponse(created)
+		if err := p.audit.RecordTx(ctx, AuditProductDetail, variantKey(created), AuditCreate, nil, resp); err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	})
+}
+
+// DeleteVariant implements ProductDetailServices.
+func (p *ProductDetailServicesImpl) DeleteVariant(ctx context.Context, productID int, variantID int) error {
+	return p.tx.WithinTx(ctx, func(ctx context.Context) error {
+		product, err := p.productRepo.FindById(ctx, productID)
+		if err != nil {
+			return err
+		}
+
+		detail, err := p.repo.FindById(ctx, variantID)
+		if err != nil {
+			return err
+		}
+
+		// variant harus milik product yang ada di path
+		if detail.CodeProduct != product.ProductCode {
+			return repository.ErrProductDetailNotFound
+		}
+
+		if err := p.repo.Delete(ctx, variantID); err != nil {
+			return err
+		}
+
+		return p.audit.RecordTx(ctx, AuditProductDetail, variantKey(detail), AuditDelete, utils.ProductDetailResponse(detail), nil)
+	})
+}
+
+// LookupBarcode implements ProductDetailServices.
+func (p *ProductDetailServicesImpl) LookupBarcode(ctx context.Context, barcode string) (*response.BarcodeLookupResponse, error) {
+	detail, err := p.repo.FindByBarcode(ctx, barcode)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.BarcodeLookupResponse(detail), nil
+}
+
+// saveWithGeneratedBarcode membuat barcode baru dan mengulang jika terjadi bentrok
+func (p *ProductDetailServicesImpl) saveWithGeneratedBarcode(ctx context.Context, detail *models.ProductDetail, barcodeType string) error {
+	var err error
+
+	for attempt := 0; attempt < barcodeGenerateAttempts; attempt++ {
+		if barcodeType == utils.BarcodeCode128 {
+			detail.Barcode, err = utils.GenerateCode128()
+		} else {
+			detail.Barcode, err = utils.GenerateEAN13()
+		}
+		if err != nil {
+			return err
+		}
+
+		// savepoint per percobaan: barcode yang bentrok tidak membatalkan transaksi luar
+		err = p.tx.WithinTx(ctx, func(ctx context.Context) error {
+			return p.repo.Save(ctx, detail)
+		})
+		if !errors.Is(err, repository.ErrBarcodeExists) {
+			return err
+		}
+	}
+
+	return err
+}
+
+// variantKey adalah entity_key audit variant: code_product/id_size
+func variantKey(detail *models.ProductDetail) string {
+	return fmt.Sprintf("%s/%d", detail.CodeProduct, detail.IDSize)
+}
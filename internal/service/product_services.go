@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
+	uuid "github.com/gofrs/uuid"
+)
+
+type ProductServicesImpl struct {
+	repo  repository.ProductRepository
+	audit AuditRecorder
+}
+
+func NewProductServices(repo repository.ProductRepository, audit AuditRecorder) ProductServices {
+	return &ProductServicesImpl{repo: repo, audit: audit}
+}
+
+// GetAllProduct implements ProductServices.
+func (p *ProductServicesImpl) GetAllProduct(ctx context.Context, params pagination.Params) ([]*response.ProductResponse, *response.Meta, error) {
+	products, page, err := p.repo.FindAll(ctx, params)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on services layer in method GetAllProduct when get data from repository", "error", err)
+		return nil, nil, err
+	}
+
+	return utils.ProductResponses(products), utils.PageMeta(page), nil
+}
+
+// GetProductById implements ProductServices.
+func (p *ProductServicesImpl) GetProductById(ctx context.Context, id int) (*response.ProductResponse, error) {
+	product, err := p.repo.FindById(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on services layer in method GetProductById when get data from repository", "error", err)
+		return nil, err
+	}
+
+	return utils.ProductResponse(product), nil
+}
+
+// GetProductByCode implements ProductServices.
+func (p *ProductServicesImpl) GetProductByCode(ctx context.Context, code string) (*response.ProductResponse, error) {
+	product, err := p.repo.FindByCode(ctx, code)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on services layer in method GetProductByCode when get data from repository", "error", err)
+		return nil, err
+	}
+
+	return utils.ProductResponse(product), nil
+}
+
+// CreateProduct implements ProductServices.
+func (p *ProductServicesImpl) CreateProduct(ctx context.Context, req *request.CreateProduct) (*response.ProductResponse, error) {
+	productCode, err := uuid.NewV6()
+	if err != nil {
+		slog.ErrorContext(ctx, "error when create product_code", "error", err)
+		return nil, err
+	}
+
+	product := &models.Product{
+		ProductName:        req.ProductName,
+		Price:              req.Price,
+		DescriptionProduct: req.DescriptionProduct,
+		ProductCode:        productCode.String(),
+		IDCategory:         uint(req.IDCategory),
+	}
+
+	if err := p.repo.Save(ctx, product); err != nil {
+		slog.ErrorContext(ctx, "error on services layer in method CreateProduct when save product", "error", err)
+		return nil, err
+	}
+
+	// ambil ulang agar nama category ikut terisi
+	created, err := p.GetProductById(ctx, int(product.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	p.audit.Record(ctx, AuditProduct, product.ProductCode, AuditCreate, nil, created)
+	return created, nil
+}
+
+// UpdateProduct implements ProductServices.
+func (p *ProductServicesImpl) UpdateProduct(ctx context.Context, id int, req *request.UpdateProduct) error {
+	// 1. READ - Ambil data product yang sudah ada
+	existing, err := p.repo.FindById(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to find product: %w", err)
+	}
+	before := utils.ProductResponse(existing)
+
+	// 2. MODIFY - Hanya field yang dikirim yang diubah
+	if req.ProductName != nil && *req.ProductName != "" {
+		existing.ProductName = *req.ProductName
+	}
+
+	if req.Price != nil {
+		existing.Price = *req.Price
+	}
+
+	if req.DescriptionProduct != nil {
+		existing.DescriptionProduct = *req.DescriptionProduct
+	}
+
+	if req.IDCategory != nil {
+		existing.IDCategory = uint(*req.IDCategory)
+	}
+
+	// 3. WRITE - Simpan perubahan
+	if err := p.repo.Update(ctx, existing); err != nil {
+		return fmt.Errorf("failed to update product: %w", err)
+	}
+
+	// ambil ulang agar nama category yang baru ikut tercatat
+	updated, err := p.GetProductById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	p.audit.Record(ctx, AuditProduct, existing.ProductCode, AuditUpdate, before, updated)
+	return nil
+}
+
+// DeleteProduct implements ProductServices.
+func (p *ProductServicesImpl) DeleteProduct(ctx context.Context, id int) error {
+	existing, err := p.repo.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := p.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	p.audit.Record(ctx, AuditProduct, existing.ProductCode, AuditDelete, utils.ProductResponse(existing), nil)
+	return nil
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+)
+
+// permission dibaca ulang dari database paling lambat setiap permissionCacheTTL,
+// supaya perubahan dari instance lain tetap terbaca tanpa query di setiap request
+const permissionCacheTTL = 30 * time.Second
+
+type RoleServicesImpl struct {
+	repo repository.RoleRepository
+
+	mu          sync.RWMutex
+	permissions map[uint]map[auth.Permission]bool
+	loadedAt    time.Time
+}
+
+func NewRoleServices(repo repository.RoleRepository) RoleServices {
+	return &RoleServicesImpl{
+		repo: repo,
+	}
+}
+
+// GetAllRole implements RoleServices.
+func (r *RoleServicesImpl) GetAllRole(ctx context.Context, p pagination.Params) ([]*response.RoleResponse, *response.Meta, error) {
+	roles, page, err := r.repo.FindAll(ctx, p)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on layer services in GetAllRole when get all role", "error", err)
+		return nil, nil, err
+	}
+
+	return utils.RoleResponses(roles), utils.PageMeta(page), nil
+}
+
+// GetRoleById implements RoleServices.
+func (r *RoleServicesImpl) GetRoleById(ctx context.Context, id int) (*response.RoleResponse, error) {
+	role, err := r.repo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.RoleResponse(role), nil
+}
+
+// CreateRole implements RoleServices.
+func (r *RoleServicesImpl) CreateRole(ctx context.Context, req *request.CreateRole) (*response.RoleResponse, error) {
+	permissions, err := normalizePermissions(req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.ensureGrantable(ctx, permissions); err != nil {
+		return nil, err
+	}
+
+	role := &models.Role{
+		RoleName:    req.RoleName,
+		Permissions: permissions,
+	}
+
+	if err := r.repo.Save(ctx, role); err != nil {
+		slog.ErrorContext(ctx, "error on layer services in CreateRole when save role", "error", err)
+		return nil, err
+	}
+	r.invalidate()
+
+	return r.GetRoleById(ctx, int(role.ID))
+}
+
+// UpdateRole implements RoleServices.
+func (r *RoleServicesImpl) UpdateRole(ctx context.Context, id int, req *request.UpdateRole) (*response.RoleResponse, error) {
+	role, err := r.repo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.RoleName != nil {
+		// nama super admin dipakai untuk bypass permission, jadi tidak boleh diganti
+		if role.RoleName == auth.RoleSuperAdmin && *req.RoleName != auth.RoleSuperAdmin {
+			return nil, ErrRoleProtected
+		}
+		role.RoleName = *req.RoleName
+	}
+
+	if err := r.repo.Update(ctx, role); err != nil {
+		slog.ErrorContext(ctx, "error on layer services in UpdateRole when update role", "error", err)
+		return nil, err
+	}
+	r.invalidate()
+
+	return r.GetRoleById(ctx, id)
+}
+
+// SetPermissions implements RoleServices.
+func (r *RoleServicesImpl) SetPermissions(ctx context.Context, id int, req *request.SetRolePermissions) (*response.RoleResponse, error) {
+	permissions, err := normalizePermissions(req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.ensureGrantable(ctx, permissions); err != nil {
+		return nil, err
+	}
+
+	if err := r.repo.SetPermissions(ctx, id, permissions); err != nil {
+		slog.ErrorContext(ctx, "error on layer services in SetPermissions when set role permissions", "error", err)
+		return nil, err
+	}
+	r.invalidate()
+
+	return r.GetRoleById(ctx, id)
+}
+
+// DeleteRole implements RoleServices.
+func (r *RoleServicesImpl) DeleteRole(ctx context.Context, id int) error {
+	role, err := r.repo.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if role.RoleName == auth.RoleSuperAdmin {
+		return ErrRoleProtected
+	}
+
+	if err := r.repo.Delete(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "error on layer services in DeleteRole when delete role", "error", err)
+		return err
+	}
+	r.invalidate()
+
+	return nil
+}
+
+// GetAllPermission implements RoleServices.
+func (r *RoleServicesImpl) GetAllPermission(ctx context.Context) []string {
+	permissions := make([]string, 0, len(auth.AllPermissions))
+	for _, p := range auth.AllPermissions {
+		permissions = append(permissions, string(p))
+	}
+	return permissions
+}
+
+// HasPermission implements RoleServices.
+func (r *RoleServicesImpl) HasPermission(ctx context.Context, claims *auth.Claims, permission auth.Permission) (bool, error) {
+	if claims.Role == auth.RoleSuperAdmin {
+		return true, nil
+	}
+
+	permissions, err := r.cachedPermissions(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return permissions[claims.IDRole][permission], nil
+}
+
+// CanAssignRole implements RoleServices. Role hanya boleh diberikan jika semua
+// permission-nya juga dimiliki pemanggil, sehingga admin tidak bisa membuat atau
+// menaikkan employee menjadi super admin. Role super admin hanya bisa diberikan
+// oleh super admin karena nama role tersebut mem-bypass semua permission.
+func (r *RoleServicesImpl) CanAssignRole(ctx context.Context, claims *auth.Claims, idRole uint) (bool, error) {
+	if claims.Role == auth.RoleSuperAdmin {
+		return true, nil
+	}
+
+	role, err := r.repo.FindById(ctx, int(idRole))
+	if errors.Is(err, repository.ErrRoleNotFound) {
+		return false, repository.ErrRoleNotFound.WithKind(apperror.KindValidation)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if role.RoleName == auth.RoleSuperAdmin {
+		return false, nil
+	}
+	return r.holdsAll(ctx, claims, role.Permissions)
+}
+
+// holdsAll mengecek apakah claims memiliki semua permissions
+func (r *RoleServicesImpl) holdsAll(ctx context.Context, claims *auth.Claims, permissions []string) (bool, error) {
+	for _, permission := range permissions {
+		ok, err := r.HasPermission(ctx, claims, auth.Permission(permission))
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// ensureGrantable memastikan user yang login memiliki semua permission yang akan
+// diberikan ke role, supaya role:write tidak bisa dipakai untuk menaikkan hak akses
+func (r *RoleServicesImpl) ensureGrantable(ctx context.Context, permissions []string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+
+	allowed, err := r.holdsAll(ctx, claims, permissions)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrPermissionGrantForbidden
+	}
+	return nil
+}
+
+// cachedPermissions mengembalikan permission semua role dari cache, memuat ulang jika sudah kedaluwarsa
+func (r *RoleServicesImpl) cachedPermissions(ctx context.Context) (map[uint]map[auth.Permission]bool, error) {
+	r.mu.RLock()
+	permissions, loadedAt := r.permissions, r.loadedAt
+	r.mu.RUnlock()
+
+	if permissions != nil && time.Since(loadedAt) < permissionCacheTTL {
+		return permissions, nil
+	}
+
+	byRole, err := r.repo.FindAllPermissions(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on layer services in HasPermission when load permissions", "error", err)
+		return nil, err
+	}
+
+	permissions = make(map[uint]map[auth.Permission]bool, len(byRole))
+	for roleID, names := range byRole {
+		set := make(map[auth.Permission]bool, len(names))
+		for _, name := range names {
+			set[auth.Permission(name)] = true
+		}
+		permissions[roleID] = set
+	}
+
+	r.mu.Lock()
+	r.permissions, r.loadedAt = permissions, time.Now()
+	r.mu.Unlock()
+
+	return permissions, nil
+}
+
+// invalidate membuang cache setelah role atau permission berubah
+func (r *RoleServicesImpl) invalidate() {
+	r.mu.Lock()
+	r.permissions = nil
+	r.mu.Unlock()
+}
+
+// normalizePermissions menolak permission yang tidak dikenal dan membuang duplikat
+func normalizePermissions(names []string) ([]string, error) {
+	seen := make(map[string]bool, len(names))
+	permissions := make([]string, 0, len(names))
+
+	for _, name := range names {
+		if !auth.IsKnownPermission(auth.Permission(name)) {
+			return nil, ErrUnknownPermission
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		permissions = append(permissions, name)
+	}
+
+	sort.Strings(permissions)
+	return permissions, nil
+}
@@ -2,40 +2,49 @@ package service
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
 )
 
 type SizeServicesImpl struct {
-	repo repository.SizeRepository
+	repo  repository.SizeRepository
+	audit AuditRecorder
 }
 
-func NewSizeServices(repo repository.SizeRepository) SizeServices {
-	return &SizeServicesImpl{repo: repo}
+func NewSizeServices(repo repository.SizeRepository, audit AuditRecorder) SizeServices {
+	return &SizeServicesImpl{repo: repo, audit: audit}
 }
 
 // DeleteSize implements SizeServices.
 func (s *SizeServicesImpl) DeleteSize(ctx context.Context, id int) error {
-	err := s.repo.Delete(ctx, id)
+	existing, err := s.repo.FindById(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	err = s.repo.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, AuditSize, strconv.Itoa(id), AuditDelete, utils.SizeResponse(existing), nil)
 	return nil
 }
 
 // GetAllSize implements SizeServices.
-func (s *SizeServicesImpl) GetAllSize(ctx context.Context) ([]*response.SizeResponse, error) {
-	models, err := s.repo.FindAll(ctx)
+func (s *SizeServicesImpl) GetAllSize(ctx context.Context, p pagination.Params) ([]*response.SizeResponse, *response.Meta, error) {
+	models, page, err := s.repo.FindAll(ctx, p)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return utils.SizeReponses(models), nil
+	return utils.SizeReponses(models), utils.PageMeta(page), nil
 }
 
 // SaveSize implements SizeServices.
@@ -48,19 +57,27 @@ func (s *SizeServicesImpl) SaveSize(ctx context.Context, size *request.CreateSiz
 		return err
 	}
 
+	s.audit.Record(ctx, AuditSize, strconv.Itoa(int(sizes.ID)), AuditCreate, nil, utils.SizeResponse(&sizes))
 	return nil
 }
 
 // UpdateSize implements SizeServices.
 func (s *SizeServicesImpl) UpdateSize(ctx context.Context, size *request.UpdatedSize, id int) error {
-	err := s.repo.Update(ctx, &models.Size{
+	existing, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	updated := &models.Size{
 		ID:   uint(id),
 		Name: size.Name,
-	})
+	}
+	err = s.repo.Update(ctx, updated)
 
 	if err != nil {
 		return err
 	}
 
+	s.audit.Record(ctx, AuditSize, strconv.Itoa(id), AuditUpdate, utils.SizeResponse(existing), utils.SizeResponse(updated))
 	return nil
 }
@@ -0,0 +1,481 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+)
+
+// prefix nomor dokumen untuk setiap tipe transaksi
+var transactionCodePrefix = map[string]string{
+	models.TransactionInbound:  "IN",
+	models.TransactionOutbound: "OUT",
+	models.TransactionTransfer: "TR",
+}
+
+type TransactionServicesImpl struct {
+	repo          repository.TransactionRepository
+	warehouseRepo repository.WarehouseRepository
+	audit         AuditRecorder
+	tx            repository.TxManager
+}
+
+// NewTransactionServices membuat TransactionServices. Setiap perubahan dokumen (cek status,
+// tulis ke repository, catat audit dan baca ulang hasilnya) dijalankan dalam satu transaksi tx.
+func NewTransactionServices(repo repository.TransactionRepository, warehouseRepo repository.WarehouseRepository, audit AuditRecorder, tx repository.TxManager) TransactionServices {
+	return &TransactionServicesImpl{
+		repo:          repo,
+		warehouseRepo: warehouseRepo,
+		audit:         audit,
+		tx:            tx,
+	}
+}
+
+// transactionAudit adalah snapshot audit dokumen: status dan perubahan stok yang
+// dijalankan untuk mencapai status tersebut
+type transactionAudit struct {
+	Status         string                         `json:"status"`
+	StockMovements []repository.InventoryMovement `json:"stock_movements,omitempty"`
+}
+
+// GetTransaction implements TransactionServices.
+func (t *TransactionServicesImpl) GetTransaction(ctx context.Context, code string) (*response.TransactionResponse, error) {
+	trx, err := t.loadTransaction(ctx, code)
+	if err != nil {
+		slog.ErrorContext(ctx, "error on services layer in method GetTransaction when get data from repository", "error", err)
+		return nil, err
+	}
+
+	return utils.TransactionResponse(trx), nil
+}
+
+// CreateInbound implements TransactionServices.
+// Dokumen penerimaan barang dibuat dengan status Pending; stok baru bertambah saat dokumen di-complete.
+func (t *TransactionServicesImpl) CreateInbound(ctx context.Context, req *request.CreateInboundTransaction) (*response.TransactionResponse, error) {
+	return withinTx(ctx, t.tx, func(ctx context.Context) (*response.TransactionResponse, error) {
+		employeeCode, err := actorEmployeeCode(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := ensureWarehouseScope(ctx, req.DestinationWarehouseCode); err != nil {
+			return nil, err
+		}
+		if err := t.ensureWarehouse(ctx, req.DestinationWarehouseCode); err != nil {
+			return nil, err
+		}
+
+		code, err := t.nextCode(ctx, models.TransactionInbound)
+		if err != nil {
+			return nil, err
+		}
+
+		trx := &models.Transaction{
+			CodeTransaksi:         code,
+			OriginEntityName:      req.OriginEntityName,
+			DestinationEntityName: req.DestinationWarehouseCode,
+			EmployeeCode:          employeeCode,
+			IDStatus:              models.StatusPending,
+			TipeTransaksi:         models.TransactionInbound,
+			Details:               mergeItems(req.Items),
+		}
+
+		if err := t.repo.Save(ctx, trx); err != nil {
+			slog.ErrorContext(ctx, "error on services layer in method CreateInbound when save transaction", "error", err)
+			return nil, err
+		}
+
+		return t.created(ctx, code)
+	})
+}
+
+// CreateOutbound implements TransactionServices.
+// Stok warehouse asal dipesan saat dokumen dibuat (dokumen Pending) dan baru dikurangi saat dokumen di-complete.
+// Jika ada baris yang melebihi stok tersedia, dokumen tidak dibuat dan seluruh kekurangannya dikembalikan.
+func (t *TransactionServicesImpl) CreateOutbound(ctx context.Context, req *request.CreateOutboundTransaction) (*response.TransactionResponse, error) {
+	return withinTx(ctx, t.tx, func(ctx context.Context) (*response.TransactionResponse, error) {
+		employeeCode, err := actorEmployeeCode(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := ensureWarehouseScope(ctx, req.OriginWarehouseCode); err != nil {
+			return nil, err
+		}
+		if err := t.ensureWarehouse(ctx, req.OriginWarehouseCode); err != nil {
+			return nil, err
+		}
+
+		code, err := t.nextCode(ctx, models.TransactionOutbound)
+		if err != nil {
+			return nil, err
+		}
+
+		trx := &models.Transaction{
+			CodeTransaksi:         code,
+			OriginEntityName:      req.OriginWarehouseCode,
+			DestinationEntityName: req.DestinationEntityName,
+			EmployeeCode:          employeeCode,
+			IDStatus:              models.StatusPending,
+			TipeTransaksi:         models.TransactionOutbound,
+			Details:               mergeItems(req.Items),
+		}
+
+		if err := t.repo.SaveWithReservation(ctx, trx, req.OriginWarehouseCode); err != nil {
+			slog.ErrorContext(ctx, "error on services layer in method CreateOutbound when save transaction", "error", err)
+			return nil, err
+		}
+
+		return t.created(ctx, code)
+	})
+}
+
+// CreateTransfer implements TransactionServices.
+// Transfer memesan stok warehouse asal selama Pending, dikurangi saat dispatch (In Transit),
+// dan baru menambah stok warehouse tujuan saat barang diterima.
+func (t *TransactionServicesImpl) CreateTransfer(ctx context.Context, req *request.CreateTransferTransaction) (*response.TransactionResponse, error) {
+	return withinTx(ctx, t.tx, func(ctx context.Context) (*response.TransactionResponse, error) {
+		employeeCode, err := actorEmployeeCode(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// transfer dibuat oleh warehouse pengirim
+		if err := ensureWarehouseScope(ctx, req.OriginWarehouseCode); err != nil {
+			return nil, err
+		}
+		if err := t.ensureWarehouse(ctx, req.OriginWarehouseCode); err != nil {
+			return nil, err
+		}
+		if err := t.ensureWarehouse(ctx, req.DestinationWarehouseCode); err != nil {
+			return nil, err
+		}
+
+		code, err := t.nextCode(ctx, models.TransactionTransfer)
+		if err != nil {
+			return nil, err
+		}
+
+		trx := &models.Transaction{
+			CodeTransaksi:         code,
+			OriginEntityName:      req.OriginWarehouseCode,
+			DestinationEntityName: req.DestinationWarehouseCode,
+			EmployeeCode:          employeeCode,
+			IDStatus:              models.StatusPending,
+			TipeTransaksi:         models.TransactionTransfer,
+			Details:               mergeItems(req.Items),
+		}
+
+		if err := t.repo.SaveWithReservation(ctx, trx, req.OriginWarehouseCode); err != nil {
+			slog.ErrorContext(ctx, "error on services layer in method CreateTransfer when save transaction", "error", err)
+			return nil, err
+		}
+
+		return t.created(ctx, code)
+	})
+}
+
+// DispatchTransfer implements TransactionServices.
+// Barang keluar dari warehouse asal dan dokumen berstatus In Transit.
+func (t *TransactionServicesImpl) DispatchTransfer(ctx context.Context, code string) (*response.TransactionResponse, error) {
+	return withinTx(ctx, t.tx, func(ctx context.Context) (*response.TransactionResponse, error) {
+		trx, err := t.loadTransaction(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+
+		if trx.TipeTransaksi != models.TransactionTransfer {
+			return nil, ErrNotTransfer
+		}
+		// hanya warehouse pengirim yang boleh mengirim barang
+		if err := ensureWarehouseScope(ctx, trx.OriginEntityName); err != nil {
+			return nil, err
+		}
+
+		if err := t.transition(ctx, trx, models.StatusInTransit); err != nil {
+			return nil, err
+		}
+
+		return t.GetTransaction(ctx, code)
+	})
+}
+
+// ReceiveTransfer implements TransactionServices.
+// Penerimaan boleh sebagian; dokumen menjadi Completed setelah semua baris diterima penuh.
+func (t *TransactionServicesImpl) ReceiveTransfer(ctx context.Context, code string, req *request.ReceiveTransfer) (*response.TransactionResponse, error) {
+	return withinTx(ctx, t.tx, func(ctx context.Context) (*response.TransactionResponse, error) {
+		trx, err := t.loadTransaction(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+
+		if trx.TipeTransaksi != models.TransactionTransfer {
+			return nil, ErrNotTransfer
+		}
+		// hanya warehouse tujuan yang boleh menerima barang
+		if err := ensureWarehouseScope(ctx, trx.DestinationEntityName); err != nil {
+			return nil, err
+		}
+		if trx.IDStatus != models.StatusInTransit {
+			return nil, ErrTransactionNotInTransit
+		}
+
+		items := mergeItems(req.Items)
+		if err := t.repo.Receive(ctx, trx.ID, trx.DestinationEntityName, items); err != nil {
+			slog.ErrorContext(ctx, "error on services layer in method ReceiveTransfer when receive items", "error", err)
+			return nil, err
+		}
+
+		received, err := t.loadTransaction(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+
+		before := transactionAudit{Status: models.StatusNames[trx.IDStatus]}
+		after := transactionAudit{Status: models.StatusNames[received.IDStatus], StockMovements: receivedMovements(trx, items)}
+		if err := t.audit.RecordTx(ctx, AuditTransaction, code, AuditUpdate, before, after); err != nil {
+			return nil, err
+		}
+
+		return utils.TransactionResponse(received), nil
+	})
+}
+
+// CompleteTransaction implements TransactionServices.
+func (t *TransactionServicesImpl) CompleteTransaction(ctx context.Context, code string) (*response.TransactionResponse, error) {
+	return withinTx(ctx, t.tx, func(ctx context.Context) (*response.TransactionResponse, error) {
+		trx, err := t.loadTransaction(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := t.transition(ctx, trx, models.StatusCompleted); err != nil {
+			return nil, err
+		}
+
+		return t.GetTransaction(ctx, code)
+	})
+}
+
+// ChangeStatus implements TransactionServices.
+// Perpindahan status mengikuti transactionTransitions; selain itu dikembalikan ErrIllegalStatusTransition.
+// Transfer hanya bisa dikirim lewat DispatchTransfer dan diselesaikan lewat ReceiveTransfer, supaya
+// scope warehouse, permission dan pencatatan penerimaan sebagian tidak bisa dilewati.
+func (t *TransactionServicesImpl) ChangeStatus(ctx context.Context, code string, req *request.ChangeTransactionStatus) (*response.TransactionResponse, error) {
+	return withinTx(ctx, t.tx, func(ctx context.Context) (*response.TransactionResponse, error) {
+		trx, err := t.loadTransaction(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case req.IDStatus == models.StatusInTransit:
+			return nil, ErrTransferSentByDispatch
+		case req.IDStatus == models.StatusCompleted && trx.TipeTransaksi == models.TransactionTransfer:
+			return nil, ErrTransferCompletedByReceive
+		}
+
+		if err := t.transition(ctx, trx, req.IDStatus); err != nil {
+			return nil, err
+		}
+
+		return t.GetTransaction(ctx, code)
+	})
+}
+
+// ScanItem implements TransactionServices.
+// Barcode hasil scan dicocokkan ke baris dokumen; scanner_quantity tidak boleh melebihi quantity baris.
+func (t *TransactionServicesImpl) ScanItem(ctx context.Context, code string, req *request.ScanItem) (*response.ScanProgressResponse, error) {
+	return withinTx(ctx, t.tx, func(ctx context.Context) (*response.ScanProgressResponse, error) {
+		trx, err := t.loadTransaction(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+
+		// dokumen yang sudah Completed / Failed tidak bisa di-scan lagi
+		if isFinalStatus(trx.IDStatus) {
+			return nil, ErrTransactionClosed
+		}
+
+		quantity := req.Quantity
+		if quantity == 0 {
+			quantity = 1
+		}
+
+		idDetailProduct, err := t.repo.Scan(ctx, trx.ID, req.Barcode, quantity)
+		if err != nil {
+			slog.ErrorContext(ctx, "error on services layer in method ScanItem when scan barcode", "error", err)
+			return nil, err
+		}
+
+		// baca ulang supaya progres yang dikembalikan sesuai kondisi terbaru
+		trx, err = t.loadTransaction(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.ScanProgressResponse(trx, idDetailProduct), nil
+	})
+}
+
+// completionMovements menentukan perubahan stok saat dokumen selesai sesuai tipenya
+func completionMovements(trx *models.Transaction) ([]repository.InventoryMovement, error) {
+	switch trx.TipeTransaksi {
+	case models.TransactionInbound:
+		// barang masuk ke warehouse tujuan
+		return detailMovements(trx.Details, trx.DestinationEntityName, 1), nil
+	case models.TransactionOutbound:
+		// barang keluar dari warehouse asal
+		return detailMovements(trx.Details, trx.OriginEntityName, -1), nil
+	case models.TransactionTransfer:
+		// transfer berjalan lewat dispatch dan receive
+		return nil, ErrTransferCompletedByReceive
+	default:
+		return nil, ErrUnknownTransactionType
+	}
+}
+
+// receivedMovements membuat perubahan stok warehouse tujuan untuk barang transfer yang diterima
+func receivedMovements(trx *models.Transaction, items []models.DetailTransaction) []repository.InventoryMovement {
+	variants := make(map[uint]models.ProductDetail, len(trx.Details))
+	for _, d := range trx.Details {
+		variants[d.IDDetailProduct] = d.ProductDetail
+	}
+
+	movements := make([]repository.InventoryMovement, 0, len(items))
+	for _, item := range items {
+		variant := variants[item.IDDetailProduct]
+		movements = append(movements, repository.InventoryMovement{
+			CodeProduct:   variant.CodeProduct,
+			IDSize:        variant.IDSize,
+			CodeWarehouse: trx.DestinationEntityName,
+			Delta:         item.Quantity,
+		})
+	}
+	return movements
+}
+
+// detailMovements membuat perubahan stok untuk setiap baris detail di warehouse tertentu.
+// sign bernilai 1 untuk menambah stok dan -1 untuk mengurangi.
+func detailMovements(details []models.DetailTransaction, codeWarehouse string, sign int) []repository.InventoryMovement {
+	movements := make([]repository.InventoryMovement, 0, len(details))
+	for _, d := range details {
+		movements = append(movements, repository.InventoryMovement{
+			CodeProduct:   d.ProductDetail.CodeProduct,
+			IDSize:        d.ProductDetail.IDSize,
+			CodeWarehouse: codeWarehouse,
+			Delta:         sign * d.Quantity,
+		})
+	}
+	return movements
+}
+
+// mergeItems menggabungkan baris dengan variant yang sama, karena satu dokumen
+// hanya boleh memiliki satu baris untuk setiap id_detail_product
+func mergeItems(items []request.TransactionItem) []models.DetailTransaction {
+	index := make(map[int]int, len(items))
+	details := make([]models.DetailTransaction, 0, len(items))
+
+	for _, item := range items {
+		if i, ok := index[item.IDDetailProduct]; ok {
+			details[i].Quantity += item.Quantity
+			continue
+		}
+
+		index[item.IDDetailProduct] = len(details)
+		details = append(details, models.DetailTransaction{
+			IDDetailProduct: uint(item.IDDetailProduct),
+			Quantity:        item.Quantity,
+		})
+	}
+
+	return details
+}
+
+// nextCode membuat nomor dokumen yang mudah dibaca, contoh: IN-20250101-000042
+func (t *TransactionServicesImpl) nextCode(ctx context.Context, tipe string) (string, error) {
+	prefix, ok := transactionCodePrefix[tipe]
+	if !ok {
+		return "", ErrUnknownTransactionType
+	}
+
+	seq, err := t.repo.NextCodeSequence(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%s-%06d", prefix, time.Now().Format("20060102"), seq), nil
+}
+
+// withinTx menjalankan fn dalam transaksi tx dan mengembalikan hasilnya. fn bisa diulang
+// jika transaksi bertabrakan, jadi hasil hanya diambil dari percobaan yang berhasil.
+func withinTx[T any](ctx context.Context, tx repository.TxManager, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx)
+		return err
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return result, nil
+}
+
+// actorEmployeeCode mengembalikan employee yang sedang login sebagai pencatat dokumen
+func actorEmployeeCode(ctx context.Context) (string, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok || claims.EmployeeCode == "" {
+		return "", ErrUnauthenticated
+	}
+	return claims.EmployeeCode, nil
+}
+
+// created membaca ulang dokumen yang baru dibuat dan mencatatnya ke audit trail
+func (t *TransactionServicesImpl) created(ctx context.Context, code string) (*response.TransactionResponse, error) {
+	resp, err := t.GetTransaction(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.audit.RecordTx(ctx, AuditTransaction, code, AuditCreate, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// loadTransaction membaca dokumen dan memastikan request boleh mengakses salah satu warehouse-nya
+func (t *TransactionServicesImpl) loadTransaction(ctx context.Context, code string) (*models.Transaction, error) {
+	trx, err := t.repo.FindByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureWarehouseScope(ctx, transactionWarehouses(trx)...); err != nil {
+		return nil, err
+	}
+
+	return trx, nil
+}
+
+func (t *TransactionServicesImpl) ensureWarehouse(ctx context.Context, code string) error {
+	exists, err := t.warehouseRepo.ExistsByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// warehouse berasal dari body request, jadi 422 dan bukan 404
+		return repository.ErrWarehouseNotFound.WithKind(apperror.KindValidation)
+	}
+	return nil
+}
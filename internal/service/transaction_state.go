@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+// transactionTransitions adalah daftar perpindahan status yang sah.
+// Completed dan Failed adalah status akhir, dokumen tidak bisa diubah lagi.
+//
+//	Pending   -> Completed | Failed | In Transit (khusus transfer)
+//	In Transit -> Completed (khusus transfer, lewat penerimaan barang) | Failed (barang hilang)
+var transactionTransitions = map[uint][]uint{
+	models.StatusPending:   {models.StatusCompleted, models.StatusFailed, models.StatusInTransit},
+	models.StatusInTransit: {models.StatusCompleted, models.StatusFailed},
+}
+
+// canTransition mengecek apakah status from boleh berpindah ke status to
+func canTransition(from, to uint) bool {
+	for _, next := range transactionTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// isFinalStatus mengecek apakah dokumen sudah di status akhir
+func isFinalStatus(status uint) bool {
+	return len(transactionTransitions[status]) == 0
+}
+
+// transitionMovements menentukan perubahan stok saat dokumen masuk ke status to.
+// Stok hanya berubah saat dokumen Completed; pengecualiannya transfer yang dikirim
+// (In Transit) karena barang sudah keluar dari warehouse asal.
+// Dokumen yang Failed tidak mengubah stok, reservasinya otomatis lepas karena sudah tidak Pending.
+// Transfer In Transit yang Failed berarti sisa barang yang belum diterima hilang di perjalanan:
+// stok asal sudah berkurang saat dispatch dan stok tujuan tidak bertambah.
+func transitionMovements(trx *models.Transaction, to uint) ([]repository.InventoryMovement, error) {
+	switch to {
+	case models.StatusCompleted:
+		return completionMovements(trx)
+	case models.StatusInTransit:
+		if trx.TipeTransaksi != models.TransactionTransfer {
+			return nil, ErrNotTransfer
+		}
+		return detailMovements(trx.Details, trx.OriginEntityName, -1), nil
+	default:
+		return nil, nil
+	}
+}
+
+// transition memindahkan status dokumen sesuai state machine, menjalankan perubahan
+// stoknya dan mencatat audit-nya dalam satu transaksi database
+func (t *TransactionServicesImpl) transition(ctx context.Context, trx *models.Transaction, to uint) error {
+	if _, ok := models.StatusNames[to]; !ok {
+		return ErrUnknownStatus
+	}
+
+	if !canTransition(trx.IDStatus, to) {
+		return ErrIllegalStatusTransition
+	}
+
+	movements, err := transitionMovements(trx, to)
+	if err != nil {
+		return err
+	}
+
+	if err := t.repo.UpdateStatus(ctx, trx.ID, trx.IDStatus, to, movements); err != nil {
+		slog.ErrorContext(ctx, "error on services layer in method transition when update status", "error", err)
+		return err
+	}
+
+	before := transactionAudit{Status: models.StatusNames[trx.IDStatus]}
+	after := transactionAudit{Status: models.StatusNames[to], StockMovements: movements}
+	return t.audit.RecordTx(ctx, AuditTransaction, trx.CodeTransaksi, AuditUpdate, before, after)
+}
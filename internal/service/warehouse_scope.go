@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+)
+
+// ensureWarehouseScope memastikan request boleh mengakses salah satu warehouse codes
+func ensureWarehouseScope(ctx context.Context, codes ...string) error {
+	scope := auth.ScopeFrom(ctx)
+	for _, code := range codes {
+		if scope.Allows(code) {
+			return nil
+		}
+	}
+	return ErrWarehouseForbidden
+}
+
+// transactionWarehouses mengembalikan warehouse yang terlibat di sebuah dokumen.
+// Sisi lain inbound (supplier) dan outbound (customer) bukan warehouse.
+func transactionWarehouses(trx *models.Transaction) []string {
+	switch trx.TipeTransaksi {
+	case models.TransactionInbound:
+		return []string{trx.DestinationEntityName}
+	case models.TransactionOutbound:
+		return []string{trx.OriginEntityName}
+	default:
+		return []string{trx.OriginEntityName, trx.DestinationEntityName}
+	}
+}
@@ -2,24 +2,25 @@ package service
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
 	uuid "github.com/gofrs/uuid"
 )
 
 type WarehouseSErvicesImpl struct {
-	repo repository.WarehouseRepository
+	repo  repository.WarehouseRepository
+	audit AuditRecorder
 }
 
-func NewWarehouseServices(repo repository.WarehouseRepository) WarehouseServices {
-	return &WarehouseSErvicesImpl{repo: repo}
+func NewWarehouseServices(repo repository.WarehouseRepository, audit AuditRecorder) WarehouseServices {
+	return &WarehouseSErvicesImpl{repo: repo, audit: audit}
 }
 
 // CreateWarehouse implements WarehouseServices.
@@ -27,41 +28,56 @@ func (w *WarehouseSErvicesImpl) CreateWarehouse(ctx context.Context, warehouse *
 	userID, err := uuid.NewV6()
 
 	if err != nil {
-		log.Println("error when create userID", err)
+		slog.ErrorContext(ctx, "error when create userID", "error", err)
 		return err
 	}
 
-	w.repo.Save(ctx, &models.Warehouse{
+	created := &models.Warehouse{
 		WarehouseName:       warehouse.WarehouseName,
-		WarehouseCode:       userID,
+		WarehouseCode:       userID.String(),
 		LocationDescription: warehouse.LocationDescription,
-	})
+	}
+	err = w.repo.Save(ctx, created)
+	if err != nil {
+		return err
+	}
 
+	w.audit.Record(ctx, AuditWarehouse, userID.String(), AuditCreate, nil, utils.WarehouseReponse(created))
 	return nil
 }
 
 // DeleteWarehouse implements WarehouseServices.
 func (w *WarehouseSErvicesImpl) DeleteWarehouse(ctx context.Context, id string) error {
-	return w.repo.Delete(ctx, id)
+	existing, err := w.repo.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := w.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	w.audit.Record(ctx, AuditWarehouse, id, AuditDelete, utils.WarehouseReponse(existing), nil)
+	return nil
 }
 
 // GetAllWarehouse implements WarehouseServices.
-func (w *WarehouseSErvicesImpl) GetAllWarehouse(ctx context.Context) ([]*response.WarehouseResponse, error) {
-	models, err := w.repo.FindAll(ctx)
+func (w *WarehouseSErvicesImpl) GetAllWarehouse(ctx context.Context, p pagination.Params) ([]*response.WarehouseResponse, *response.Meta, error) {
+	models, page, err := w.repo.FindAll(ctx, p)
 
 	if err != nil {
-		log.Println("error on services layer in method GetAllWarehouse when get data from repository", err)
-		return nil, err
+		slog.ErrorContext(ctx, "error on services layer in method GetAllWarehouse when get data from repository", "error", err)
+		return nil, nil, err
 	}
 
-	return utils.WarehouseReponses(models), nil
+	return utils.WarehouseReponses(models), utils.PageMeta(page), nil
 }
 
 // GetWarehouseById implements WarehouseServices.
 func (w *WarehouseSErvicesImpl) GetWarehouseById(ctx context.Context, id string) (*response.WarehouseResponse, error) {
 	models, err := w.repo.FindById(ctx, id)
 	if err != nil {
-		log.Println("error on services in method GetwarehouseById when get data to repo", err)
+		slog.ErrorContext(ctx, "error on services in method GetwarehouseById when get data to repo", "error", err)
 		return nil, err
 	}
 
@@ -69,14 +85,10 @@ func (w *WarehouseSErvicesImpl) GetWarehouseById(ctx context.Context, id string)
 }
 
 // UpdateWarehouse implements WarehouseServices.
-func (w *WarehouseSErvicesImpl) UpdateWarehouse(ctx context.Context, warehouse *request.UpdateWarehouse) error {
+func (w *WarehouseSErvicesImpl) UpdateWarehouse(ctx context.Context, code string, warehouse *request.UpdateWarehouse) error {
 	updates := make(map[string]any)
 
 	if warehouse.WarehouseName != nil && *warehouse.WarehouseName != "" {
-		// Validasi business logic (contoh: min 3 characters)
-		if len(*warehouse.WarehouseName) < 3 {
-			return errors.New("warehouse name must be at least 3 characters")
-		}
 		updates["warehouse_name"] = *warehouse.WarehouseName
 	}
 
@@ -86,13 +98,24 @@ func (w *WarehouseSErvicesImpl) UpdateWarehouse(ctx context.Context, warehouse *
 	}
 
 	if len(updates) == 0 {
-		return errors.New("no fields to update")
+		return repository.ErrNoFieldsToUpdate
+	}
+
+	existing, err := w.repo.FindById(ctx, code)
+	if err != nil {
+		return err
 	}
 
-	err := w.repo.Update(ctx, updates, warehouse.WarehouseCode)
+	err = w.repo.Update(ctx, updates, code)
 	if err != nil {
 		return fmt.Errorf("failed to update warehouse: %w", err)
 	}
 
+	updated, err := w.repo.FindById(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	w.audit.Record(ctx, AuditWarehouse, code, AuditUpdate, utils.WarehouseReponse(existing), utils.WarehouseReponse(updated))
 	return nil
 }
@@ -3,6 +3,7 @@ package utils
 import (
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
 	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
 )
 
 func EmployeeResponse(e *models.Employee) *response.EmployeeResponse {
@@ -25,7 +26,7 @@ func EmployeeReponses(e []*models.Employee) []*response.EmployeeResponse {
 
 func WarehouseReponse(w *models.Warehouse) *response.WarehouseResponse {
 	return &response.WarehouseResponse{
-		WarehouseCode:       w.WarehouseCode.String(),
+		WarehouseCode:       w.WarehouseCode,
 		WarehouseName:       w.WarehouseName,
 		LocationDescription: w.LocationDescription,
 	}
@@ -68,3 +69,181 @@ func SizeReponses(c []*models.Size) []*response.SizeResponse {
 	}
 	return res
 }
+
+func ProductResponse(p *models.Product) *response.ProductResponse {
+	return &response.ProductResponse{
+		ID:                 int(p.ID),
+		ProductCode:        p.ProductCode,
+		ProductName:        p.ProductName,
+		Price:              p.Price,
+		DescriptionProduct: p.DescriptionProduct,
+		IDCategory:         int(p.IDCategory),
+		CategoryName:       p.Category.Name,
+	}
+}
+
+func ProductResponses(p []*models.Product) []*response.ProductResponse {
+	var res []*response.ProductResponse
+	for _, v := range p {
+		res = append(res, ProductResponse(v))
+	}
+	return res
+}
+
+func ProductDetailResponse(d *models.ProductDetail) *response.ProductDetailResponse {
+	return &response.ProductDetailResponse{
+		ID:          int(d.ID),
+		CodeProduct: d.CodeProduct,
+		IDSize:      int(d.IDSize),
+		SizeName:    d.Size.Name,
+		Barcode:     d.Barcode,
+	}
+}
+
+func ProductDetailResponses(d []*models.ProductDetail) []*response.ProductDetailResponse {
+	var res []*response.ProductDetailResponse
+	for _, v := range d {
+		res = append(res, ProductDetailResponse(v))
+	}
+	return res
+}
+
+func BarcodeLookupResponse(d *models.ProductDetail) *response.BarcodeLookupResponse {
+	return &response.BarcodeLookupResponse{
+		IDDetailProduct: int(d.ID),
+		Barcode:         d.Barcode,
+		Product:         *ProductResponse(&d.Product),
+		Size:            *SizeResponse(&d.Size),
+		Category:        *CategeryResponse(&d.Product.Category),
+	}
+}
+
+func InventoryResponse(i *models.Inventory) *response.InventoryResponse {
+	return &response.InventoryResponse{
+		ID:            int(i.ID),
+		CodeProduct:   i.CodeProduct,
+		ProductName:   i.Product.ProductName,
+		IDSize:        int(i.IDSize),
+		SizeName:      i.Size.Name,
+		CodeWarehouse: i.CodeWarehouse,
+		WarehouseName: i.Warehouse.WarehouseName,
+		Quantity:      i.Quantity,
+	}
+}
+
+func InventoryResponses(i []*models.Inventory) []*response.InventoryResponse {
+	var res []*response.InventoryResponse
+	for _, v := range i {
+		res = append(res, InventoryResponse(v))
+	}
+	return res
+}
+
+func TransactionItemResponse(d *models.DetailTransaction) *response.TransactionItemResponse {
+	return &response.TransactionItemResponse{
+		ID:               int(d.ID),
+		IDDetailProduct:  int(d.IDDetailProduct),
+		CodeProduct:      d.ProductDetail.CodeProduct,
+		ProductName:      d.ProductDetail.Product.ProductName,
+		IDSize:           int(d.ProductDetail.IDSize),
+		SizeName:         d.ProductDetail.Size.Name,
+		Barcode:          d.ProductDetail.Barcode,
+		Quantity:         d.Quantity,
+		ScannerQuantity:  d.ScannerQuantity,
+		ReceivedQuantity: d.ReceivedQuantity,
+	}
+}
+
+func TransactionResponse(t *models.Transaction) *response.TransactionResponse {
+	res := &response.TransactionResponse{
+		ID:                    int(t.ID),
+		CodeTransaksi:         t.CodeTransaksi,
+		TipeTransaksi:         t.TipeTransaksi,
+		OriginEntityName:      t.OriginEntityName,
+		DestinationEntityName: t.DestinationEntityName,
+		EmployeeCode:          t.EmployeeCode,
+		IDStatus:              int(t.IDStatus),
+		StatusName:            t.Status.Name,
+		CreatedAt:             t.CreatedAt,
+	}
+
+	for i := range t.Details {
+		res.Items = append(res.Items, TransactionItemResponse(&t.Details[i]))
+	}
+
+	return res
+}
+
+// ScanProgressResponse menghitung progres scan dokumen; idDetailProduct adalah baris yang baru di-scan
+func ScanProgressResponse(t *models.Transaction, idDetailProduct uint) *response.ScanProgressResponse {
+	res := &response.ScanProgressResponse{
+		CodeTransaksi: t.CodeTransaksi,
+	}
+
+	for i := range t.Details {
+		item := TransactionItemResponse(&t.Details[i])
+		if t.Details[i].IDDetailProduct == idDetailProduct {
+			res.Scanned = item
+		}
+
+		res.TotalExpected += t.Details[i].Quantity
+		res.TotalScanned += t.Details[i].ScannerQuantity
+		res.Items = append(res.Items, item)
+	}
+	res.Complete = res.TotalScanned == res.TotalExpected
+
+	return res
+}
+
+func RoleResponse(r *models.Role) *response.RoleResponse {
+	permissions := r.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+
+	return &response.RoleResponse{
+		ID:          int(r.ID),
+		RoleName:    r.RoleName,
+		Permissions: permissions,
+	}
+}
+
+func RoleResponses(r []*models.Role) []*response.RoleResponse {
+	var res []*response.RoleResponse
+	for _, v := range r {
+		res = append(res, RoleResponse(v))
+	}
+	return res
+}
+
+func AuditResponse(a *models.AuditLog) *response.AuditResponse {
+	return &response.AuditResponse{
+		ID:                a.ID,
+		ActorEmployeeCode: a.ActorEmployeeCode,
+		EntityType:        a.EntityType,
+		EntityKey:         a.EntityKey,
+		Action:            a.Action,
+		Before:            a.Before,
+		After:             a.After,
+		Diff:              a.Diff,
+		RequestID:         a.RequestID,
+		CreatedAt:         a.CreatedAt,
+	}
+}
+
+func AuditResponses(a []*models.AuditLog) []*response.AuditResponse {
+	var res []*response.AuditResponse
+	for _, v := range a {
+		res = append(res, AuditResponse(v))
+	}
+	return res
+}
+
+func PageMeta(page pagination.Page) *response.Meta {
+	return &response.Meta{
+		Total:      page.Total,
+		Limit:      page.Limit,
+		Page:       page.Page,
+		NextCursor: page.NextCursor,
+	}
+}
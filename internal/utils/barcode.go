@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// Simbologi barcode yang didukung
+const (
+	BarcodeEAN13   = "ean13"
+	BarcodeCode128 = "code128"
+)
+
+// prefix GS1 200-299 dicadangkan untuk penggunaan internal (in-store),
+// sehingga barcode hasil generate tidak bentrok dengan barcode pabrikan
+const ean13InternalPrefix = "20"
+
+// panjang maksimal data Code128 yang masih nyaman dibaca scanner genggam
+const code128MaxLength = 48
+
+// karakter untuk barcode Code128 hasil generate
+const code128Alphabet = "0123456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+// EAN13CheckDigit menghitung check digit untuk 12 digit pertama EAN-13
+func EAN13CheckDigit(digits string) (int, error) {
+	if len(digits) != 12 || !isDigits(digits) {
+		return 0, errors.New("ean13 requires exactly 12 digits to compute check digit")
+	}
+
+	sum := 0
+	for i, r := range digits {
+		d := int(r - '0')
+		// posisi ganjil (dari kiri, mulai 1) bobot 1, posisi genap bobot 3
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+
+	return (10 - sum%10) % 10, nil
+}
+
+// IsValidEAN13 mengecek panjang dan check digit barcode EAN-13
+func IsValidEAN13(code string) bool {
+	if len(code) != 13 || !isDigits(code) {
+		return false
+	}
+
+	check, err := EAN13CheckDigit(code[:12])
+	if err != nil {
+		return false
+	}
+
+	return int(code[12]-'0') == check
+}
+
+// IsValidCode128 mengecek apakah data bisa di-encode sebagai Code128 (ASCII printable)
+func IsValidCode128(code string) bool {
+	if code == "" || len(code) > code128MaxLength {
+		return false
+	}
+
+	for i := 0; i < len(code); i++ {
+		if code[i] < 32 || code[i] > 126 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsValidBarcode memvalidasi barcode sesuai simbologinya.
+// Jika simbologi kosong, barcode 13 digit dianggap EAN-13 sehingga check digit-nya wajib benar.
+func IsValidBarcode(code, symbology string) bool {
+	switch {
+	case symbology == BarcodeEAN13:
+		return IsValidEAN13(code)
+	case symbology == "" && len(code) == 13 && isDigits(code):
+		return IsValidEAN13(code)
+	default:
+		return IsValidCode128(code)
+	}
+}
+
+// GenerateEAN13 membuat barcode EAN-13 acak dengan prefix internal dan check digit yang benar
+func GenerateEAN13() (string, error) {
+	body, err := randomString("0123456789", 12-len(ean13InternalPrefix))
+	if err != nil {
+		return "", err
+	}
+
+	digits := ean13InternalPrefix + body
+	check, err := EAN13CheckDigit(digits)
+	if err != nil {
+		return "", err
+	}
+
+	return digits + string(rune('0'+check)), nil
+}
+
+// GenerateCode128 membuat barcode Code128 acak dengan format WMS-XXXXXXXXXX
+func GenerateCode128() (string, error) {
+	body, err := randomString(code128Alphabet, 10)
+	if err != nil {
+		return "", err
+	}
+
+	return "WMS-" + body, nil
+}
+
+func randomString(alphabet string, length int) (string, error) {
+	var sb strings.Builder
+	max := big.NewInt(int64(len(alphabet)))
+
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(alphabet[n.Int64()])
+	}
+
+	return sb.String(), nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
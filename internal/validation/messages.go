@@ -0,0 +1,117 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Bahasa pesan validasi
+const (
+	LangID = "id"
+	LangEN = "en"
+)
+
+// messages berisi template pesan per aturan; {field} dan {param} diganti saat dipakai.
+// Aturan min dan max punya pesan berbeda untuk teks, list dan angka.
+var messages = map[string]map[string]string{
+	LangID: {
+		"required":         "{field} wajib diisi",
+		"required_without": "{field} wajib diisi jika {param} kosong",
+		"min.string":       "{field} minimal {param} karakter",
+		"min.list":         "{field} minimal berisi {param} item",
+		"min":              "{field} minimal {param}",
+		"max.string":       "{field} maksimal {param} karakter",
+		"max.list":         "{field} maksimal berisi {param} item",
+		"max":              "{field} maksimal {param}",
+		"oneof":            "{field} harus salah satu dari: {param}",
+		"nefield":          "{field} tidak boleh sama dengan {param}",
+		RuleWarehouseCode:  "{field} bukan kode warehouse yang valid",
+		RuleBarcode:        "{field} bukan barcode EAN-13 atau Code128 yang valid",
+		RulePositive:       "{field} harus lebih besar dari 0",
+		"":                 "{field} tidak valid",
+	},
+	LangEN: {
+		"required":         "{field} is required",
+		"required_without": "{field} is required when {param} is empty",
+		"min.string":       "{field} must be at least {param} characters",
+		"min.list":         "{field} must contain at least {param} items",
+		"min":              "{field} must be at least {param}",
+		"max.string":       "{field} must be at most {param} characters",
+		"max.list":         "{field} must contain at most {param} items",
+		"max":              "{field} must be at most {param}",
+		"oneof":            "{field} must be one of: {param}",
+		"nefield":          "{field} must be different from {param}",
+		RuleWarehouseCode:  "{field} is not a valid warehouse code",
+		RuleBarcode:        "{field} is not a valid EAN-13 or Code128 barcode",
+		RulePositive:       "{field} must be greater than 0",
+		"":                 "{field} is invalid",
+	},
+}
+
+// Language memilih bahasa pesan dari header Accept-Language; default bahasa Indonesia
+func Language(acceptLanguage string) string {
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, _, _ := strings.Cut(strings.TrimSpace(part), ";")
+		primary, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		switch primary {
+		case LangID, LangEN:
+			return primary
+		}
+	}
+	return LangID
+}
+
+func message(fe validator.FieldError, lang string) string {
+	templates, ok := messages[lang]
+	if !ok {
+		templates = messages[LangID]
+	}
+
+	template, ok := templates[fe.Tag()+kindSuffix(fe)]
+	if !ok {
+		template, ok = templates[fe.Tag()]
+	}
+	if !ok {
+		template = templates[""]
+	}
+
+	param := fe.Param()
+	switch fe.Tag() {
+	case "required_without", "nefield":
+		// parameter berisi nama field Go, tampilkan dengan nama json-nya
+		param = snakeCase(param)
+	}
+
+	return strings.NewReplacer("{field}", fe.Field(), "{param}", param).Replace(template)
+}
+
+func kindSuffix(fe validator.FieldError) string {
+	switch fe.Kind() {
+	case reflect.String:
+		return ".string"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return ".list"
+	default:
+		return ""
+	}
+}
+
+// snakeCase mengubah nama field Go menjadi nama json, misalnya UserID menjadi user_id
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (nextLower && unicode.IsUpper(runes[i-1])) {
+				sb.WriteByte('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
@@ -0,0 +1,176 @@
+// Package validation mendaftarkan aturan validasi custom ke validator yang dipakai gin
+// saat bind, dan mengubah error validator menjadi daftar {field, rule, message}
+// dalam bahasa Indonesia atau Inggris.
+package validation
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid"
+)
+
+// Aturan custom yang bisa dipakai di tag binding
+const (
+	// RuleWarehouseCode: kode warehouse hasil generate (UUID) atau kode seed WH-01
+	RuleWarehouseCode = "warehouse_code"
+	// RuleBarcode: EAN-13 dengan check digit yang benar atau Code128. Parameter opsional
+	// berisi nama field simbologi, misalnya barcode=BarcodeType
+	RuleBarcode = "barcode"
+	// RulePositive: angka lebih besar dari 0
+	RulePositive = "positive"
+)
+
+var (
+	ErrValidationFailed   = apperror.Validation("validation_failed", "validasi gagal, periksa kembali data yang dikirim")
+	errValidationFailedEN = apperror.Validation(ErrValidationFailed.Code, "validation failed, please check the submitted data")
+)
+
+// kode warehouse dan employee seed dari migration 000003, misalnya WH-01 dan SA-001
+var (
+	warehouseCodePattern = regexp.MustCompile(`^WH-[0-9]{2,}$`)
+	employeeCodePattern  = regexp.MustCompile(`^[A-Z]{2,}-[0-9]{3,}$`)
+)
+
+// FieldError adalah satu aturan yang dilanggar
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// Error berisi semua field yang tidak valid. errors.Is(err, ErrValidationFailed) bernilai
+// true dan response-nya 422 dengan daftar field di data.
+type Error struct {
+	Fields []FieldError
+	lang   string
+}
+
+func (e *Error) Error() string {
+	parts := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		parts = append(parts, f.Message)
+	}
+	return "validation failed: " + strings.Join(parts, "; ")
+}
+
+func (e *Error) Unwrap() error {
+	if e.lang == LangEN {
+		return errValidationFailedEN
+	}
+	return ErrValidationFailed
+}
+
+// Details menampilkan daftar field yang tidak valid di response
+func (e *Error) Details() any {
+	return e.Fields
+}
+
+// Register mendaftarkan aturan custom dan memakai nama json sebagai nama field
+func Register(v *validator.Validate) error {
+	v.RegisterTagNameFunc(jsonFieldName)
+
+	rules := map[string]validator.Func{
+		RuleWarehouseCode: validWarehouseCode,
+		RuleBarcode:       validBarcode,
+		RulePositive:      positive,
+	}
+	for tag, fn := range rules {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Translate mengubah validator.ValidationErrors menjadi *Error dengan pesan dalam bahasa lang.
+// Error lain dikembalikan apa adanya.
+func Translate(err error, lang string) error {
+	errs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return err
+	}
+
+	fields := make([]FieldError, 0, len(errs))
+	for _, fe := range errs {
+		fields = append(fields, FieldError{
+			Field:   fieldPath(fe),
+			Rule:    fe.Tag(),
+			Message: message(fe, lang),
+		})
+	}
+	return &Error{Fields: fields, lang: lang}
+}
+
+// IsValidWarehouseCode mengecek format kode warehouse
+func IsValidWarehouseCode(code string) bool {
+	if warehouseCodePattern.MatchString(code) {
+		return true
+	}
+	_, err := uuid.FromString(code)
+	return err == nil
+}
+
+// IsValidEmployeeCode mengecek format kode employee: hasil generate (UUID) atau kode
+// seed seperti SA-001
+func IsValidEmployeeCode(code string) bool {
+	if employeeCodePattern.MatchString(code) {
+		return true
+	}
+	_, err := uuid.FromString(code)
+	return err == nil
+}
+
+func validWarehouseCode(fl validator.FieldLevel) bool {
+	return IsValidWarehouseCode(fl.Field().String())
+}
+
+func validBarcode(fl validator.FieldLevel) bool {
+	symbology := ""
+	if fl.Param() != "" {
+		field, kind, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param())
+		if ok && kind == reflect.String {
+			symbology = field.String()
+		}
+	}
+	return utils.IsValidBarcode(fl.Field().String(), symbology)
+}
+
+func positive(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return field.Int() > 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return field.Uint() > 0
+	case reflect.Float32, reflect.Float64:
+		return field.Float() > 0
+	default:
+		return false
+	}
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// fieldPath membuang nama struct di depan namespace, misalnya
+// CreateInboundTransaction.items[0].quantity menjadi items[0].quantity
+func fieldPath(fe validator.FieldError) string {
+	_, path, found := strings.Cut(fe.Namespace(), ".")
+	if !found {
+		return fe.Field()
+	}
+	return path
+}
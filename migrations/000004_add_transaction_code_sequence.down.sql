@@ -0,0 +1 @@
+DROP SEQUENCE IF EXISTS "transaction_code_seq";
@@ -0,0 +1,2 @@
+-- Sequence untuk nomor dokumen transaksi yang mudah dibaca, contoh: IN-20250101-000001
+CREATE SEQUENCE IF NOT EXISTS "transaction_code_seq";
@@ -0,0 +1,25 @@
+-- Transfer yang masih In Transit sudah mengurangi stok warehouse asal tapi belum menambah
+-- stok warehouse tujuan. Status dan received_quantity-nya tidak bisa dihapus tanpa
+-- kehilangan barang tersebut, jadi rollback dihentikan sampai transfer itu selesai
+-- diterima atau ditandai Failed. Migration dijalankan dalam satu transaksi sehingga tidak ada yang berubah.
+DO $$
+DECLARE
+	in_transit INTEGER;
+BEGIN
+	SELECT count(*) INTO in_transit
+	FROM "transactions" t
+	JOIN "status" s ON s."id" = t."id_status"
+	WHERE s."name" = 'In Transit';
+
+	IF in_transit > 0 THEN
+		RAISE EXCEPTION 'cannot roll back transfer support: % transaction(s) are still In Transit', in_transit
+			USING HINT = 'receive the remaining items of those transfers, or mark them Failed, first';
+	END IF;
+END
+$$;
+
+ALTER TABLE "detail_transactions"
+	DROP CONSTRAINT IF EXISTS "detail_transactions_received_quantity_check",
+	DROP COLUMN IF EXISTS "received_quantity";
+
+DELETE FROM "status" WHERE "name" = 'In Transit';
@@ -0,0 +1,8 @@
+-- Status untuk transfer antar warehouse yang sudah dikirim tapi belum diterima seluruhnya
+INSERT INTO "status" ("name") VALUES
+('In Transit');
+
+-- Jumlah barang transfer yang sudah diterima di warehouse tujuan (mendukung penerimaan sebagian)
+ALTER TABLE "detail_transactions"
+	ADD COLUMN "received_quantity" INTEGER NOT NULL DEFAULT 0,
+	ADD CONSTRAINT "detail_transactions_received_quantity_check" CHECK ("received_quantity" BETWEEN 0 AND "quantity");
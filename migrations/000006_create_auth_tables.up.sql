@@ -0,0 +1,23 @@
+-- Refresh token disimpan dalam bentuk hash (SHA-256), token asli hanya dipegang client.
+-- Setiap login membuat satu family; rotasi membuat token baru di family yang sama
+-- sehingga pemakaian ulang token lama bisa mencabut seluruh family.
+CREATE TABLE "refresh_token" (
+	"id"            SERIAL PRIMARY KEY,
+	"token_hash"    TEXT NOT NULL UNIQUE,
+	"employee_code" TEXT NOT NULL,
+	"family_id"     TEXT NOT NULL,
+	"expires_at"    TIMESTAMPTZ NOT NULL,
+	"revoked_at"    TIMESTAMPTZ,
+	"replaced_by"   INTEGER,
+	"created_at"    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY ("employee_code") REFERENCES "employee" ("employee_code") ON DELETE CASCADE ON UPDATE CASCADE,
+	FOREIGN KEY ("replaced_by") REFERENCES "refresh_token" ("id") ON DELETE SET NULL
+);
+
+CREATE INDEX "refresh_token_family_id_idx" ON "refresh_token" ("family_id");
+
+-- Access token (jti) yang dicabut lewat logout sebelum masa berlakunya habis
+CREATE TABLE "revoked_token" (
+	"jti"        TEXT PRIMARY KEY,
+	"expires_at" TIMESTAMPTZ NOT NULL
+);
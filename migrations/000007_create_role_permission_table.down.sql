@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "role_permission";
+
+ALTER TABLE "role" DROP CONSTRAINT IF EXISTS "role_role_name_key";
@@ -0,0 +1,44 @@
+-- Nama role harus unik supaya permission bisa dikelola per role
+ALTER TABLE "role" ADD CONSTRAINT "role_role_name_key" UNIQUE ("role_name");
+
+-- Permission bernama yang dimiliki setiap role, contoh: 'warehouse:delete'
+CREATE TABLE "role_permission" (
+	"id_role"    INTEGER NOT NULL,
+	"permission" TEXT NOT NULL,
+	PRIMARY KEY ("id_role", "permission"),
+	FOREIGN KEY ("id_role") REFERENCES "role" ("id") ON DELETE CASCADE
+);
+
+-- Permission awal: role yang lebih tinggi mendapat semua permission role di bawahnya
+INSERT INTO "role_permission" ("id_role", "permission")
+SELECT r."id", p."permission"
+FROM "role" r
+CROSS JOIN unnest(ARRAY[
+	'warehouse:read', 'master:read', 'product:read', 'inventory:read',
+	'transaction:read', 'transaction:create', 'transaction:scan'
+]) AS p("permission")
+WHERE r."role_name" IN ('employee', 'manager', 'admin', 'super admin');
+
+INSERT INTO "role_permission" ("id_role", "permission")
+SELECT r."id", p."permission"
+FROM "role" r
+CROSS JOIN unnest(ARRAY[
+	'employee:read', 'master:write', 'product:write', 'inventory:adjust',
+	'transaction:dispatch', 'transaction:receive', 'transaction:complete', 'transaction:status'
+]) AS p("permission")
+WHERE r."role_name" IN ('manager', 'admin', 'super admin');
+
+INSERT INTO "role_permission" ("id_role", "permission")
+SELECT r."id", p."permission"
+FROM "role" r
+CROSS JOIN unnest(ARRAY[
+	'employee:write', 'employee:delete', 'warehouse:write', 'warehouse:delete', 'warehouse:all',
+	'master:delete', 'product:delete', 'role:read'
+]) AS p("permission")
+WHERE r."role_name" IN ('admin', 'super admin');
+
+INSERT INTO "role_permission" ("id_role", "permission")
+SELECT r."id", p."permission"
+FROM "role" r
+CROSS JOIN unnest(ARRAY['role:write', 'role:delete']) AS p("permission")
+WHERE r."role_name" = 'super admin';
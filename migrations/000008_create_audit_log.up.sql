@@ -0,0 +1,26 @@
+-- Jejak audit setiap create/update/delete dari layer service. Tidak ada foreign key
+-- ke employee maupun entity yang diubah supaya catatan tetap ada walaupun datanya dihapus.
+CREATE TABLE "audit_log" (
+	"id"                  BIGSERIAL PRIMARY KEY,
+	"actor_employee_code" TEXT,
+	"entity_type"         TEXT NOT NULL,
+	"entity_key"          TEXT NOT NULL,
+	"action"              TEXT NOT NULL,
+	-- snapshot sebelum dan sesudah perubahan; NULL untuk create (before) dan delete (after)
+	"before"              JSONB,
+	"after"               JSONB,
+	-- field yang berubah dalam bentuk {"field": {"before": ..., "after": ...}}
+	"diff"                JSONB NOT NULL DEFAULT '{}',
+	"request_id"          TEXT,
+	"created_at"          TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX "audit_log_entity_idx" ON "audit_log" ("entity_type", "entity_key");
+CREATE INDEX "audit_log_actor_idx" ON "audit_log" ("actor_employee_code");
+CREATE INDEX "audit_log_created_at_idx" ON "audit_log" ("created_at");
+
+-- Audit trail hanya bisa dibaca admin dan super admin
+INSERT INTO "role_permission" ("id_role", "permission")
+SELECT r."id", 'audit:read'
+FROM "role" r
+WHERE r."role_name" IN ('admin', 'super admin');
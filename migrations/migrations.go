@@ -0,0 +1,11 @@
+// Package migrations menyimpan file SQL migration di dalam binary supaya
+// perintah migrate tidak bergantung pada direktori kerja saat dijalankan.
+//
+// Nama file mengikuti format <versi>_<nama>.up.sql dan <versi>_<nama>.down.sql,
+// versi baru harus lebih besar dari versi terakhir yang sudah ada.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
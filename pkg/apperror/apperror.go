@@ -0,0 +1,109 @@
+// Package apperror berisi error domain bertipe yang dipakai semua layer.
+// Setiap error memiliki Kind (menentukan HTTP status) dan Code yang stabil
+// sehingga client bisa membedakan error tanpa membaca pesan.
+package apperror
+
+import (
+	"errors"
+	"net/http"
+)
+
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindBadRequest
+	KindValidation
+	KindUnauthorized
+	KindForbidden
+	KindNotFound
+	KindConflict
+)
+
+// HTTPStatus memetakan kind ke HTTP status code
+func (k Kind) HTTPStatus() int {
+	switch k {
+	case KindBadRequest:
+		return http.StatusBadRequest
+	case KindValidation:
+		return http.StatusUnprocessableEntity
+	case KindUnauthorized:
+		return http.StatusUnauthorized
+	case KindForbidden:
+		return http.StatusForbidden
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindConflict:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Error adalah error domain. Dua Error dengan Code yang sama dianggap error yang sama
+// oleh errors.Is, walaupun salah satunya sudah dibungkus dengan Wrap atau WithKind.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Err     error
+}
+
+func New(kind Kind, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+func NotFound(code, message string) *Error     { return New(KindNotFound, code, message) }
+func Conflict(code, message string) *Error     { return New(KindConflict, code, message) }
+func Validation(code, message string) *Error   { return New(KindValidation, code, message) }
+func BadRequest(code, message string) *Error   { return New(KindBadRequest, code, message) }
+func Unauthorized(code, message string) *Error { return New(KindUnauthorized, code, message) }
+func Forbidden(code, message string) *Error    { return New(KindForbidden, code, message) }
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// Wrap mengembalikan salinan e dengan cause, misalnya sql.ErrNoRows atau *pq.Error,
+// supaya penyebab aslinya tetap ada di log
+func (e *Error) Wrap(cause error) *Error {
+	c := *e
+	c.Err = cause
+	return &c
+}
+
+// WithKind mengembalikan salinan e dengan kind lain. Dipakai jika error yang sama
+// berarti hal berbeda tergantung asalnya, misalnya warehouse yang tidak ditemukan
+// dari URL (404) dibanding dari body request (422).
+func (e *Error) WithKind(kind Kind) *Error {
+	c := *e
+	c.Kind = kind
+	return &c
+}
+
+// From mengambil *Error dari rantai err
+func From(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
+
+// Detailer diimplementasikan error yang membawa data tambahan untuk client,
+// misalnya rincian baris yang stoknya kurang
+type Detailer interface {
+	Details() any
+}
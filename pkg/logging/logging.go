@@ -0,0 +1,79 @@
+// Package logging menyiapkan logger terstruktur (log/slog) untuk seluruh aplikasi.
+// Request ID yang disimpan di context.Context otomatis ikut di setiap baris log
+// yang ditulis dengan slog.*Context, mulai dari middleware sampai repository.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// RequestIDKey adalah nama atribut request ID di setiap baris log
+const RequestIDKey = "request_id"
+
+type requestIDKey struct{}
+
+// New membuat logger dengan format json atau text dan level minimal yang diberikan
+func New(w io.Writer, format string, level slog.Level) (*slog.Logger, error) {
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	switch strings.ToLower(format) {
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	case FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q, use %s or %s", format, FormatJSON, FormatText)
+	}
+
+	return slog.New(contextHandler{handler}), nil
+}
+
+// ParseLevel membaca level log: debug, info, warn atau error
+func ParseLevel(value string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(value)); err != nil {
+		return level, fmt.Errorf("unknown log level %q, use debug, info, warn or error", value)
+	}
+	return level, nil
+}
+
+// WithRequestID menyimpan request ID di context
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID mengambil request ID dari context, string kosong jika tidak ada
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// contextHandler menambahkan request ID dari context ke setiap record
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		r.AddAttrs(slog.String(RequestIDKey, id))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
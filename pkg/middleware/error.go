@@ -0,0 +1,86 @@
+// Package middleware berisi middleware gin yang dipakai bersama oleh WMS dan service lain:
+// request ID, access log, recovery panic dan penulisan response untuk error domain
+// (apperror). Middleware yang bergantung pada auth WMS ada di internal/middelware.
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorHandler menulis response untuk error yang dicatat handler lewat ctx.Error.
+// Error domain (*apperror.Error) dikirim dengan status sesuai kind-nya beserta code
+// yang bisa dibaca mesin; error lain menjadi 500 tanpa membocorkan pesan aslinya.
+func ErrorHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		if len(ctx.Errors) == 0 || ctx.Writer.Written() {
+			return
+		}
+
+		writeError(ctx, ctx.Errors.Last().Err)
+	}
+}
+
+func writeError(ctx *gin.Context, err error) {
+	// Cek A: Apakah error karena Timeout?
+	if errors.Is(err, context.DeadlineExceeded) {
+		ctx.JSON(http.StatusGatewayTimeout, response.ApiResponse{
+			Status:  http.StatusGatewayTimeout,
+			Message: "Request Timeout",
+			Code:    "timeout",
+			Data:    nil,
+		})
+		return
+	}
+
+	// Cek B: Apakah error karena Client Cancel (Tutup koneksi)?
+	if errors.Is(err, context.Canceled) {
+		ctx.JSON(http.StatusRequestTimeout, response.ApiResponse{
+			Status:  http.StatusRequestTimeout,
+			Message: "Request dibatalkan oleh client",
+			Code:    "request_canceled",
+			Data:    nil,
+		})
+		return
+	}
+
+	// Cek C: Error domain, beserta data tambahan jika ada (misalnya rincian stok yang kurang)
+	if appErr, ok := apperror.From(err); ok && appErr.Kind != apperror.KindInternal {
+		var data any
+		var detailer apperror.Detailer
+		if errors.As(err, &detailer) {
+			data = detailer.Details()
+		}
+
+		status := appErr.Kind.HTTPStatus()
+		ctx.JSON(status, response.ApiResponse{
+			Status:  status,
+			Message: appErr.Message,
+			Code:    appErr.Code,
+			Data:    data,
+		})
+		return
+	}
+
+	slog.ErrorContext(ctx.Request.Context(), "unhandled error on request",
+		"method", ctx.Request.Method, "route", ctx.FullPath(), "error", err)
+	AbortInternalError(ctx)
+}
+
+// AbortInternalError menghentikan request dengan 500 tanpa membocorkan detail error
+func AbortInternalError(ctx *gin.Context) {
+	ctx.AbortWithStatusJSON(http.StatusInternalServerError, response.ApiResponse{
+		Status:  http.StatusInternalServerError,
+		Message: "Terjadi kesalahan pada server",
+		Code:    "internal_error",
+		Data:    nil,
+	})
+}
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"runtime/debug"
+	"strings"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/logging"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+)
+
+// CorrelationHeader berisi ID yang juga tercatat di log panic, supaya laporan
+// dari client bisa dicocokkan dengan stack trace-nya. Nilainya sama dengan request ID
+// jika middleware RequestID dipasang.
+const CorrelationHeader = "X-Correlation-ID"
+
+// Recovery menangkap panic dari handler, mencatat nilai panic beserta stack trace
+// lewat logger, lalu mengirim 500 dengan correlation ID. Request yang tidak panic
+// tidak disentuh sama sekali.
+func Recovery(logger *slog.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+
+			// http.ErrAbortHandler sengaja dipakai untuk memutus response, biarkan net/http menanganinya
+			if rec == http.ErrAbortHandler {
+				panic(rec)
+			}
+
+			correlationID := logging.RequestID(ctx.Request.Context())
+			if correlationID == "" {
+				correlationID = newCorrelationID()
+			}
+			logger.ErrorContext(ctx.Request.Context(), "panic recovered",
+				slog.String("correlation_id", correlationID),
+				slog.Any("panic", rec),
+				slog.String("method", ctx.Request.Method),
+				slog.String("path", ctx.Request.URL.Path),
+				slog.String("stack", string(debug.Stack())),
+			)
+
+			// koneksi yang sudah putus tidak bisa ditulisi response lagi
+			if brokenPipe(rec) {
+				ctx.Abort()
+				return
+			}
+
+			// jika handler sudah sempat menulis response, status-nya tidak bisa diganti
+			if ctx.Writer.Written() {
+				ctx.Abort()
+				return
+			}
+
+			ctx.Header(CorrelationHeader, correlationID)
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, response.ApiResponse{
+				Status:  http.StatusInternalServerError,
+				Message: "Terjadi kesalahan pada server, sertakan correlation_id saat melapor",
+				Code:    "internal_error",
+				Data:    gin.H{"correlation_id": correlationID},
+			})
+		}()
+
+		ctx.Next()
+	}
+}
+
+func newCorrelationID() string {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "unknown"
+	}
+	return id.String()
+}
+
+// brokenPipe mengecek apakah panic disebabkan client yang menutup koneksi
+func brokenPipe(rec any) bool {
+	err, ok := rec.(error)
+	if !ok {
+		return false
+	}
+
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		return false
+	}
+
+	var syscallErr *os.SyscallError
+	if !errors.As(opErr, &syscallErr) {
+		return false
+	}
+
+	msg := strings.ToLower(syscallErr.Error())
+	return strings.Contains(msg, "broken pipe") || strings.Contains(msg, "connection reset by peer")
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDHeader dibaca dari request dan selalu dikirim balik di response
+const RequestIDHeader = "X-Request-ID"
+
+// panjang maksimal request ID dari client, supaya log tidak bisa dibanjiri
+const maxRequestIDLength = 128
+
+// RequestID memakai X-Request-ID dari client jika formatnya wajar, atau membuat yang baru.
+// ID disimpan di context request sehingga ikut tercatat di semua log service dan repository.
+func RequestID() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := ctx.GetHeader(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newCorrelationID()
+		}
+
+		ctx.Request = ctx.Request.WithContext(logging.WithRequestID(ctx.Request.Context(), id))
+		ctx.Header(RequestIDHeader, id)
+
+		ctx.Next()
+	}
+}
+
+// AccessLog mencatat setiap request yang selesai beserta status dan lama prosesnya
+func AccessLog(logger *slog.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+
+		ctx.Next()
+
+		status := ctx.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= 500:
+			level = slog.LevelError
+		case status >= 400:
+			level = slog.LevelWarn
+		}
+
+		logger.LogAttrs(ctx.Request.Context(), level, "request completed",
+			slog.String("method", ctx.Request.Method),
+			slog.String("path", ctx.Request.URL.Path),
+			slog.String("route", ctx.FullPath()),
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("client_ip", ctx.ClientIP()),
+			slog.Int("size", ctx.Writer.Size()),
+		)
+	}
+}
+
+// validRequestID hanya menerima huruf, angka dan - _ . : supaya aman ditulis ke log
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,111 @@
+// Package postgres membuat koneksi PostgreSQL dengan pengaturan connection pool
+// dan menyediakan health check yang bisa dipakai service lain.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq" // PostgreSQL driver
+)
+
+// DefaultPingTimeout dipakai jika Config.PingTimeout atau timeout health check bernilai 0
+const DefaultPingTimeout = 5 * time.Second
+
+// Config adalah pengaturan koneksi dan connection pool
+type Config struct {
+	// DSN dalam format URL (postgres://...) atau key=value yang diterima lib/pq
+	DSN             string
+	MaxIdleConns    int
+	MaxOpenConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+	// PingTimeout membatasi ping pertama saat Open
+	PingTimeout time.Duration
+}
+
+// Open membuka connection pool dan memastikan database bisa dihubungi.
+// Pool ditutup kembali jika ping gagal.
+func Open(ctx context.Context, config Config) (*sql.DB, error) {
+	db, err := sql.Open("postgres", config.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("open database connection: %w", err)
+	}
+
+	db.SetMaxIdleConns(config.MaxIdleConns)
+	db.SetMaxOpenConns(config.MaxOpenConns)
+	db.SetConnMaxLifetime(config.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(config.ConnMaxIdleTime)
+
+	if err := Check(ctx, db, config.PingTimeout); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// Check memastikan database bisa menjalankan query dalam batas timeout
+func Check(ctx context.Context, db *sql.DB, timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = DefaultPingTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var one int
+	if err := db.QueryRowContext(ctx, `SELECT 1`).Scan(&one); err != nil {
+		return fmt.Errorf("ping database: %w", err)
+	}
+	return nil
+}
+
+// ParseIsolationLevel membaca nama isolation level transaksi seperti di SQL, misalnya
+// "read committed", "repeatable_read" atau "SERIALIZABLE". String kosong dan "default"
+// berarti default database.
+func ParseIsolationLevel(value string) (sql.IsolationLevel, error) {
+	name := strings.Join(strings.Fields(strings.NewReplacer("_", " ", "-", " ").Replace(strings.ToLower(value))), " ")
+	switch name {
+	case "", "default":
+		return sql.LevelDefault, nil
+	case "read committed":
+		return sql.LevelReadCommitted, nil
+	case "repeatable read":
+		return sql.LevelRepeatableRead, nil
+	case "serializable":
+		return sql.LevelSerializable, nil
+	}
+	return sql.LevelDefault, fmt.Errorf("unknown isolation level %q", value)
+}
+
+// Health adalah isi response HealthHandler
+type Health struct {
+	Status          string `json:"status"`
+	Error           string `json:"error,omitempty"`
+	OpenConnections int    `json:"open_connections"`
+	InUse           int    `json:"in_use"`
+	Idle            int    `json:"idle"`
+}
+
+// HealthHandler menjawab 200 jika database sehat dan 503 jika tidak, beserta statistik pool.
+// Bisa dipasang di gin dengan gin.WrapH.
+func HealthHandler(db *sql.DB, timeout time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		health, status := Health{Status: "ok"}, http.StatusOK
+		if err := Check(r.Context(), db, timeout); err != nil {
+			health.Status, health.Error, status = "unavailable", "database is not reachable", http.StatusServiceUnavailable
+		}
+
+		stats := db.Stats()
+		health.OpenConnections, health.InUse, health.Idle = stats.OpenConnections, stats.InUse, stats.Idle
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(health)
+	})
+}
@@ -0,0 +1,22 @@
+// Package response berisi envelope JSON yang dipakai semua response WMS, termasuk
+// response error dari middleware di pkg/middleware, sehingga service lain yang
+// memasang WMS bisa membaca response dengan struct yang sama.
+package response
+
+type ApiResponse struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	// Code adalah kode error yang stabil untuk dibaca mesin, kosong jika request berhasil
+	Code string `json:"code,omitempty"`
+	Data any    `json:"data"`
+	Meta *Meta  `json:"meta,omitempty"`
+}
+
+// Meta adalah informasi pagination untuk response list.
+// NextCursor kosong berarti tidak ada halaman berikutnya.
+type Meta struct {
+	Total      int64  `json:"total"`
+	Limit      int    `json:"limit"`
+	Page       int    `json:"page,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,167 @@
+// Package wms menyusun seluruh API WMS (repository, service, handler dan route)
+// sehingga bisa dipasang ke gin engine milik service lain:
+//
+//	app, err := wms.New(db, wms.Options{JWTSecret: secret})
+//	if err != nil { ... }
+//	engine.Use(middleware.RequestID(), middleware.Recovery(logger))
+//	app.Register(engine.Group("/api/v1"))
+//
+// Skema database harus sudah dimigrasi, lihat perintah `migrate` di cmd.
+package wms
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	database "github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/config"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/handler"
+	authmiddleware "github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/middelware"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/routes"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/validation"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/middleware"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/postgres"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// Options adalah pengaturan token dan transaksi WMS. Field durasi yang bernilai 0
+// memakai nilai default.
+type Options struct {
+	// JWTSecret dipakai untuk tanda tangan access token, minimal 32 byte
+	JWTSecret       []byte
+	Issuer          string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	// TxIsolation adalah isolation level transaksi yang mencakup beberapa repository
+	TxIsolation sql.IsolationLevel
+	// TxMaxRetries adalah jumlah percobaan ulang transaksi setelah serialization
+	// failure. 0 memakai nilai default, nilai negatif mematikan percobaan ulang.
+	TxMaxRetries int
+}
+
+// App adalah API WMS yang siap dipasang ke router
+type App struct {
+	db        *sql.DB
+	handlers  routes.Handlers
+	tokens    *auth.TokenManager
+	auth      service.AuthServices
+	roles     service.RoleServices
+	employees service.EmployeeServices
+}
+
+// New menyusun API WMS di atas db. Aturan validasi WMS didaftarkan ke validator
+// binding gin, jadi berlaku untuk seluruh engine.
+func New(db *sql.DB, opts Options) (*App, error) {
+	authConfig := database.DefaultAuthConfig()
+	authConfig.JWTSecret = database.Secret(opts.JWTSecret)
+	if opts.Issuer != "" {
+		authConfig.Issuer = opts.Issuer
+	}
+	if opts.AccessTokenTTL != 0 {
+		authConfig.AccessTokenTTL = opts.AccessTokenTTL
+	}
+	if opts.RefreshTokenTTL != 0 {
+		authConfig.RefreshTokenTTL = opts.RefreshTokenTTL
+	}
+	if err := authConfig.Validate(); err != nil {
+		return nil, err
+	}
+	tokens := auth.NewTokenManager(authConfig)
+
+	// Aturan validasi custom didaftarkan ke validator gin supaya berlaku saat bind
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		if err := validation.Register(v); err != nil {
+			return nil, err
+		}
+	}
+
+	// Repository
+	employeeRepository := repository.NewEmployeeRepository(db)
+	warehouseRepository := repository.NewWarehouseRepository(db)
+	categoryRepository := repository.NewCategoryRepository(db)
+	sizeRepository := repository.NewSizeRepository(db)
+	productRepository := repository.NewProductRepository(db)
+	productDetailRepository := repository.NewProductDetailRepository(db)
+	inventoryRepository := repository.NewInventoryRepository(db)
+	transactionRepository := repository.NewTransactionRepository(db)
+	authRepository := repository.NewAuthRepository(db)
+	roleRepository := repository.NewRoleRepository(db)
+	auditRepository := repository.NewAuditRepository(db)
+	txManager := repository.NewTxManager(db, txOptions(opts))
+
+	// Service
+	auditServices := service.NewAuditServices(auditRepository)
+	roleServices := service.NewRoleServices(roleRepository)
+	employeeServices := service.NewEmployeeServices(employeeRepository, roleServices, auditServices)
+	warehouseServices := service.NewWarehouseServices(warehouseRepository, auditServices)
+	categoryServices := service.NewCategoryServices(categoryRepository, auditServices)
+	sizeServices := service.NewSizeServices(sizeRepository, auditServices)
+	productServices := service.NewProductServices(productRepository, auditServices)
+	productDetailServices := service.NewProductDetailServices(productDetailRepository, productRepository, auditServices, txManager)
+	inventoryServices := service.NewInventoryServices(inventoryRepository, productDetailRepository, auditServices, txManager)
+	transactionServices := service.NewTransactionServices(transactionRepository, warehouseRepository, auditServices, txManager)
+	authServices := service.NewAuthServices(employeeRepository, authRepository, tokens)
+
+	// Handler
+	handlers := routes.Handlers{
+		Employee:    handler.NewEmployeeHandler(employeeServices),
+		Warehouse:   handler.NewWarehouseHandler(warehouseServices),
+		Category:    handler.NewCategoryHandler(categoryServices),
+		Size:        handler.NewSizeHandlerImpl(sizeServices),
+		Product:     handler.NewProductHandler(productServices),
+		Variant:     handler.NewProductDetailHandler(productDetailServices),
+		Inventory:   handler.NewInventoryHandler(inventoryServices),
+		Transaction: handler.NewTransactionHandler(transactionServices),
+		Auth:        handler.NewAuthHandler(authServices),
+		Role:        handler.NewRoleHandler(roleServices),
+		Audit:       handler.NewAuditHandler(auditServices),
+	}
+
+	return &App{
+		db:        db,
+		handlers:  handlers,
+		tokens:    tokens,
+		auth:      authServices,
+		roles:     roleServices,
+		employees: employeeServices,
+	}, nil
+}
+
+func txOptions(opts Options) repository.TxOptions {
+	tx := repository.TxOptions{Isolation: opts.TxIsolation, MaxRetries: opts.TxMaxRetries}
+	switch {
+	case opts.TxMaxRetries == 0:
+		tx.MaxRetries = repository.DefaultTxMaxRetries
+	case opts.TxMaxRetries < 0:
+		tx.MaxRetries = 0
+	}
+	return tx
+}
+
+// BootstrapAdminPassword memasang password super admin SA-001 dari data awal. Password
+// hanya dipasang selama SA-001 masih memakai placeholder migration, jadi aman dipanggil
+// setiap start; set bernilai false jika password sudah pernah dipasang.
+func (a *App) BootstrapAdminPassword(ctx context.Context, password string) (set bool, err error) {
+	return a.employees.BootstrapAdminPassword(ctx, password)
+}
+
+// Register memasang semua route WMS di bawah r, misalnya engine.Group("/api/v1").
+//
+// ErrorHandler ikut dipasang pada group ini karena handler WMS melaporkan error lewat
+// ctx.Error. RequestID, AccessLog dan Recovery tidak dipasang; pasang di engine
+// pemanggil supaya berlaku juga untuk route miliknya sendiri.
+func (a *App) Register(r gin.IRouter) {
+	api := r.Group("", middleware.ErrorHandler())
+
+	api.GET("/health", gin.WrapH(postgres.HealthHandler(a.db, 0)))
+	routes.Register(api, routes.PublicTable(a.handlers), nil)
+
+	// selain login, refresh dan health, semua route membutuhkan access token
+	protected := api.Group("", authmiddleware.Authenticate(a.tokens, a.auth), authmiddleware.ScopeWarehouse(a.roles))
+	routes.Register(protected, routes.Table(a.handlers), a.roles)
+}
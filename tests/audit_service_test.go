@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository/memory"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+)
+
+// failingAudit meniru audit_log yang gagal ditulis
+type failingAudit struct {
+	service.AuditServices
+}
+
+func (failingAudit) RecordTx(context.Context, string, string, string, any, any) error {
+	return errAbort
+}
+
+// auditEntries mengembalikan audit entity tertentu, urut dari yang terbaru
+func auditEntries(t *testing.T, store *memory.Store, entityType, entityKey string) []*models.AuditLog {
+	t.Helper()
+
+	entries, _, err := memory.NewAuditRepository(store).FindAll(context.Background(),
+		listParams(map[string]string{"entity_type": entityType, "entity_key": entityKey}))
+	expectErr(t, err, nil)
+	return entries
+}
+
+func newTransactionServices(store *memory.Store, audit service.AuditRecorder) service.TransactionServices {
+	return service.NewTransactionServices(memory.NewTransactionRepository(store), memory.NewWarehouseRepository(store), audit, memory.NewTxManager(store))
+}
+
+func TestTransactionStockChangesAreAudited(t *testing.T) {
+	ctx := loggedIn(&auth.Claims{EmployeeCode: "EMP-001", WarehouseCode: "WH-02"})
+	inbound := &request.CreateInboundTransaction{
+		OriginEntityName:         "PT Supplier",
+		DestinationWarehouseCode: "WH-01",
+		Items:                    []request.TransactionItem{{IDDetailProduct: 1, Quantity: 4}},
+	}
+
+	t.Run("complete records status and stock movement", func(t *testing.T) {
+		store := newMemoryStore(t)
+		transactions := newTransactionServices(store, service.NewAuditServices(memory.NewAuditRepository(store)))
+
+		trx, err := transactions.CreateInbound(ctx, inbound)
+		expectErr(t, err, nil)
+		_, err = transactions.CompleteTransaction(ctx, trx.CodeTransaksi)
+		expectErr(t, err, nil)
+
+		entries := auditEntries(t, store, service.AuditTransaction, trx.CodeTransaksi)
+		if len(entries) != 2 || entries[0].Action != service.AuditUpdate || entries[1].Action != service.AuditCreate {
+			t.Fatalf("expected create and update audit entries, got %+v", entries)
+		}
+
+		var after struct {
+			Status         string                         `json:"status"`
+			StockMovements []repository.InventoryMovement `json:"stock_movements"`
+		}
+		expectErr(t, json.Unmarshal(entries[0].After, &after), nil)
+		want := repository.InventoryMovement{CodeProduct: "PRD-001", IDSize: 1, CodeWarehouse: "WH-01", Delta: 4}
+		if after.Status != "Completed" || len(after.StockMovements) != 1 || after.StockMovements[0] != want {
+			t.Fatalf("expected completed status with movement %+v, got %+v", want, after)
+		}
+		if entries[0].ActorEmployeeCode != "EMP-001" {
+			t.Fatalf("expected actor EMP-001, got %q", entries[0].ActorEmployeeCode)
+		}
+	})
+
+	t.Run("audit failure rolls back stock change", func(t *testing.T) {
+		store := newMemoryStore(t)
+		audit := service.NewAuditServices(memory.NewAuditRepository(store))
+
+		trx, err := newTransactionServices(store, audit).CreateInbound(ctx, inbound)
+		expectErr(t, err, nil)
+
+		_, err = newTransactionServices(store, failingAudit{audit}).CompleteTransaction(ctx, trx.CodeTransaksi)
+		expectErr(t, err, errAbort)
+
+		if got := stockOf(t, context.Background(), memory.NewInventoryRepository(store), "PRD-001", 1, "WH-01"); got != 10 {
+			t.Fatalf("expected stock to stay 10, got %d", got)
+		}
+		stored, err := memory.NewTransactionRepository(store).FindByCode(context.Background(), trx.CodeTransaksi)
+		expectErr(t, err, nil)
+		if stored.IDStatus != models.StatusPending {
+			t.Fatalf("expected transaction to stay Pending, got status %d", stored.IDStatus)
+		}
+	})
+}
+
+func TestVariantChangesAreAudited(t *testing.T) {
+	ctx := loggedIn(adminClaims)
+	store := newMemoryStore(t)
+	newVariants := func(audit service.AuditRecorder) service.ProductDetailServices {
+		return service.NewProductDetailServices(memory.NewProductDetailRepository(store), memory.NewProductRepository(store), audit, memory.NewTxManager(store))
+	}
+	audit := service.NewAuditServices(memory.NewAuditRepository(store))
+
+	// PRD-002 (id 2) belum punya variant size 1
+	variant, err := newVariants(audit).CreateVariant(ctx, 2, &request.CreateProductDetail{IDSize: 1})
+	expectErr(t, err, nil)
+
+	t.Run("audit failure rolls back delete", func(t *testing.T) {
+		err := newVariants(failingAudit{audit}).DeleteVariant(ctx, 2, variant.ID)
+		expectErr(t, err, errAbort)
+
+		_, err = memory.NewProductDetailRepository(store).FindById(context.Background(), variant.ID)
+		expectErr(t, err, nil)
+	})
+
+	expectErr(t, newVariants(audit).DeleteVariant(ctx, 2, variant.ID), nil)
+
+	entries := auditEntries(t, store, service.AuditProductDetail, "PRD-002/1")
+	if len(entries) != 2 || entries[0].Action != service.AuditDelete || entries[1].Action != service.AuditCreate {
+		t.Fatalf("expected create and delete audit entries, got %+v", entries)
+	}
+}
+
+func TestStockAdjustmentIsAudited(t *testing.T) {
+	ctx := loggedIn(adminClaims)
+	store := newMemoryStore(t)
+	newInventories := func(audit service.AuditRecorder) service.InventoryServices {
+		return service.NewInventoryServices(memory.NewInventoryRepository(store), memory.NewProductDetailRepository(store), audit, memory.NewTxManager(store))
+	}
+	audit := service.NewAuditServices(memory.NewAuditRepository(store))
+	adjust := &request.AdjustInventory{CodeProduct: "PRD-001", IDSize: 1, CodeWarehouse: "WH-01", Quantity: -3}
+
+	t.Run("audit failure rolls back adjustment", func(t *testing.T) {
+		_, err := newInventories(failingAudit{audit}).AdjustStock(ctx, adjust)
+		expectErr(t, err, errAbort)
+
+		if got := stockOf(t, context.Background(), memory.NewInventoryRepository(store), "PRD-001", 1, "WH-01"); got != 10 {
+			t.Fatalf("expected stock to stay 10, got %d", got)
+		}
+	})
+
+	_, err := newInventories(audit).AdjustStock(ctx, adjust)
+	expectErr(t, err, nil)
+
+	entries := auditEntries(t, store, service.AuditInventory, "PRD-001/1/WH-01")
+	if len(entries) != 1 || entries[0].Action != service.AuditUpdate {
+		t.Fatalf("expected one update audit entry, got %+v", entries)
+	}
+}
@@ -0,0 +1,182 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	database "github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/config"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	authmiddleware "github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/middelware"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository/memory"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+)
+
+func newTokenManager() *auth.TokenManager {
+	config := database.DefaultAuthConfig()
+	config.JWTSecret = "test-secret-test-secret-test-secret"
+	return auth.NewTokenManager(config)
+}
+
+func newAuthServices(store *memory.Store) service.AuthServices {
+	return service.NewAuthServices(memory.NewEmployeeRepository(store), memory.NewAuthRepository(store), newTokenManager())
+}
+
+// loginAdmin memasang password SA-001 lalu login sebagai SA-001
+func loginAdmin(t *testing.T, store *memory.Store, authServices service.AuthServices) *response.TokenResponse {
+	t.Helper()
+
+	_, err := newEmployeeServices(store).BootstrapAdminPassword(context.Background(), "rahasia-awal")
+	expectErr(t, err, nil)
+
+	res, err := authServices.Login(context.Background(), &request.Login{EmployeeCode: "SA-001", Password: "rahasia-awal"})
+	expectErr(t, err, nil)
+	return res
+}
+
+func TestLogin(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore(t)
+	authServices := newAuthServices(store)
+	res := loginAdmin(t, store, authServices)
+
+	if res.EmployeeCode != "SA-001" || res.Role != auth.RoleSuperAdmin || res.WarehouseCode != "WH-01" || res.RefreshToken == "" {
+		t.Fatalf("unexpected token response %+v", res)
+	}
+	claims, err := newTokenManager().ParseAccessToken(res.AccessToken)
+	expectErr(t, err, nil)
+	if claims.EmployeeCode != "SA-001" || claims.IDRole != roleSuperAdmin {
+		t.Fatalf("unexpected access token claims %+v", claims)
+	}
+
+	tests := []struct {
+		name string
+		req  request.Login
+	}{
+		{"wrong password", request.Login{EmployeeCode: "SA-001", Password: "salah-password"}},
+		{"unknown employee", request.Login{EmployeeCode: "XX-999", Password: "rahasia-awal"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := authServices.Login(ctx, &tt.req)
+			expectErr(t, err, service.ErrInvalidCredentials)
+		})
+	}
+}
+
+func TestRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	refresh := func(authServices service.AuthServices, token string) (*response.TokenResponse, error) {
+		return authServices.Refresh(ctx, &request.RefreshToken{RefreshToken: token})
+	}
+
+	t.Run("rotation", func(t *testing.T) {
+		store := newMemoryStore(t)
+		authServices := newAuthServices(store)
+		login := loginAdmin(t, store, authServices)
+
+		next, err := refresh(authServices, login.RefreshToken)
+		expectErr(t, err, nil)
+		if next.RefreshToken == login.RefreshToken || next.AccessToken == "" {
+			t.Fatalf("expected a new refresh token, got %+v", next)
+		}
+
+		// token hasil rotasi tetap bisa dipakai
+		_, err = refresh(authServices, next.RefreshToken)
+		expectErr(t, err, nil)
+	})
+
+	t.Run("reuse revokes the family", func(t *testing.T) {
+		store := newMemoryStore(t)
+		authServices := newAuthServices(store)
+		login := loginAdmin(t, store, authServices)
+		other := loginAdmin(t, store, authServices)
+
+		next, err := refresh(authServices, login.RefreshToken)
+		expectErr(t, err, nil)
+
+		// token lama dipakai lagi: dianggap bocor
+		_, err = refresh(authServices, login.RefreshToken)
+		expectErr(t, err, service.ErrInvalidRefreshToken)
+
+		// token terbaru satu family ikut dicabut, sesi login lain tidak
+		_, err = refresh(authServices, next.RefreshToken)
+		expectErr(t, err, service.ErrInvalidRefreshToken)
+		_, err = refresh(authServices, other.RefreshToken)
+		expectErr(t, err, nil)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		_, err := refresh(newAuthServices(newMemoryStore(t)), "bukan-refresh-token")
+		expectErr(t, err, service.ErrInvalidRefreshToken)
+	})
+}
+
+func TestLogout(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore(t)
+	authServices := newAuthServices(store)
+	login := loginAdmin(t, store, authServices)
+
+	claims, err := newTokenManager().ParseAccessToken(login.AccessToken)
+	expectErr(t, err, nil)
+	expectErr(t, authServices.Logout(ctx, claims, &request.Logout{RefreshToken: login.RefreshToken}), nil)
+
+	revoked, err := authServices.IsTokenRevoked(ctx, claims.ID)
+	expectErr(t, err, nil)
+	if !revoked {
+		t.Fatal("expected access token to be revoked")
+	}
+	_, err = authServices.Refresh(ctx, &request.RefreshToken{RefreshToken: login.RefreshToken})
+	expectErr(t, err, service.ErrInvalidRefreshToken)
+
+	t.Run("revoked access token is rejected", func(t *testing.T) {
+		r := newAuthRouter(t, nil, authmiddleware.Authenticate(newTokenManager(), authServices))
+		authenticate := func(accessToken string) int {
+			req := httptest.NewRequest(http.MethodGet, "/scope", nil)
+			req.Header.Set("Authorization", "Bearer "+accessToken)
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+			return rec.Code
+		}
+
+		if status := authenticate(login.AccessToken); status != http.StatusUnauthorized {
+			t.Fatalf("expected 401 for the logged out token, got %d", status)
+		}
+		if status := authenticate(loginAdmin(t, store, authServices).AccessToken); status != http.StatusOK {
+			t.Fatalf("expected 200 for a new login, got %d", status)
+		}
+	})
+}
+
+func TestBootstrapAdminPassword(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore(t)
+	employees := newEmployeeServices(store)
+	login := func(password string) error {
+		_, err := newAuthServices(store).Login(ctx, &request.Login{EmployeeCode: service.BootstrapAdminCode, Password: password})
+		return err
+	}
+
+	// password placeholder dari migration tidak bisa dipakai login
+	expectErr(t, login("ganti_dengan_password_hash"), service.ErrInvalidCredentials)
+
+	set, err := employees.BootstrapAdminPassword(ctx, "rahasia-awal")
+	expectErr(t, err, nil)
+	if !set {
+		t.Fatal("expected bootstrap to set the placeholder password")
+	}
+	expectErr(t, login("rahasia-awal"), nil)
+
+	// restart dengan password lain tidak menimpa password yang sudah dipasang
+	set, err = employees.BootstrapAdminPassword(ctx, "password-lain")
+	expectErr(t, err, nil)
+	if set {
+		t.Fatal("expected bootstrap to skip an already set password")
+	}
+	expectErr(t, login("rahasia-awal"), nil)
+	expectErr(t, login("password-lain"), service.ErrInvalidCredentials)
+}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	database "github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/config"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/logging"
+)
+
+// writeConfigFile menulis file konfigurasi KEY=VALUE sementara
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "wms.env")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config file: %v", err)
+	}
+	return path
+}
+
+func TestConfigLoad(t *testing.T) {
+	t.Run("environment overrides file", func(t *testing.T) {
+		path := writeConfigFile(t, "WMS_HTTP_PORT=9090\nWMS_JWT_ISSUER=dari-file\n")
+		t.Setenv("WMS_JWT_ISSUER", "dari-env")
+
+		config, err := database.Load(path)
+		expectErr(t, err, nil)
+		if config.HTTP.Port != 9090 || config.Auth.Issuer != "dari-env" {
+			t.Fatalf("expected port 9090 from file and issuer from env, got %d %q", config.HTTP.Port, config.Auth.Issuer)
+		}
+		// key yang tidak diisi tetap memakai nilai default
+		if config.Log.Format != database.DefaultLogConfig().Format {
+			t.Fatalf("expected default log format, got %q", config.Log.Format)
+		}
+	})
+
+	t.Run("unknown file keys are rejected", func(t *testing.T) {
+		path := writeConfigFile(t, "WMS_HTTP_PROT=9090\nPASSWORD=rahasia\n")
+
+		_, err := database.Load(path)
+		if err == nil {
+			t.Fatal("expected unknown keys to be rejected")
+		}
+		for _, key := range []string{"WMS_HTTP_PROT", "PASSWORD"} {
+			if !strings.Contains(err.Error(), "unknown config key "+key) {
+				t.Fatalf("expected %s to be reported, got %v", key, err)
+			}
+		}
+	})
+
+	t.Run("invalid values are reported", func(t *testing.T) {
+		path := writeConfigFile(t, "WMS_HTTP_PORT=delapan\nWMS_JWT_ACCESS_TTL=sebentar\n")
+
+		_, err := database.Load(path)
+		if err == nil || !strings.Contains(err.Error(), "WMS_HTTP_PORT") || !strings.Contains(err.Error(), "WMS_JWT_ACCESS_TTL") {
+			t.Fatalf("expected both invalid values to be reported, got %v", err)
+		}
+	})
+
+	t.Run("bootstrap admin password length", func(t *testing.T) {
+		config := database.Default()
+		config.Auth.JWTSecret = "test-secret-test-secret-test-secret"
+		config.Auth.BootstrapAdminPassword = "pendek"
+
+		err := config.Auth.Validate()
+		if err == nil || !strings.Contains(err.Error(), "WMS_BOOTSTRAP_ADMIN_PASSWORD") {
+			t.Fatalf("expected bootstrap password to be rejected, got %v", err)
+		}
+	})
+}
+
+func TestConfigSecretsAreRedactedInLogs(t *testing.T) {
+	secrets := []string{"db-password-rahasia", "jwt-secret-rahasia-jwt-secret-rahasia", "admin-rahasia"}
+	path := writeConfigFile(t, "WMS_DB_PASSWORD="+secrets[0]+"\nWMS_JWT_SECRET="+secrets[1]+"\nWMS_BOOTSTRAP_ADMIN_PASSWORD="+secrets[2]+"\n")
+
+	config, err := database.Load(path)
+	expectErr(t, err, nil)
+	if config.Auth.JWTSecret.Reveal() != secrets[1] {
+		t.Fatalf("expected Reveal to return the secret, got %q", config.Auth.JWTSecret.Reveal())
+	}
+
+	for _, format := range []string{logging.FormatJSON, logging.FormatText} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger, err := logging.New(&buf, format, slog.LevelDebug)
+			expectErr(t, err, nil)
+
+			// sama seperti log konfigurasi di cmd/main.go
+			logger.Debug("configuration loaded", "config", config, "jwt_secret", config.Auth.JWTSecret)
+
+			out := buf.String()
+			for _, secret := range secrets {
+				if strings.Contains(out, secret) {
+					t.Fatalf("secret %q leaked into log: %s", secret, out)
+				}
+			}
+			if !strings.Contains(out, "[REDACTED]") {
+				t.Fatalf("expected redacted secrets in log, got %s", out)
+			}
+		})
+	}
+}
@@ -1,13 +1,12 @@
 package tests
 
 import (
-	database "manajemen_warehouse/internal/config"
 	"testing"
 )
 
 func TestDbPing(t *testing.T) {
 
-	db := database.NewDB()
+	db := newTestDB(t)
 	err := db.Ping()
 
 	if err != nil {
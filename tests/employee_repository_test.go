@@ -0,0 +1,193 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository/memory"
+)
+
+func TestEmployeeRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := repository.NewEmployeeRepository(db)
+	ctx := context.Background()
+
+	t.Run("FindByLogin", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			login    string
+			wantCode string
+			wantRole string
+			wantErr  error
+		}{
+			{name: "by user id", login: "staff-uid", wantCode: "EMP-001", wantRole: "employee"},
+			{name: "by employee code", login: "SA-001", wantCode: "SA-001", wantRole: "super admin"},
+			{name: "not found", login: "nobody", wantErr: repository.ErrEmployeeNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				employee, err := repo.FindByLogin(ctx, tt.login)
+				expectErr(t, err, tt.wantErr)
+				if tt.wantErr != nil {
+					return
+				}
+				if employee.EmployeeCode != tt.wantCode || employee.Role.RoleName != tt.wantRole {
+					t.Fatalf("expected %s with role %q, got %s with role %q", tt.wantCode, tt.wantRole, employee.EmployeeCode, employee.Role.RoleName)
+				}
+			})
+		}
+	})
+
+	t.Run("FindById", func(t *testing.T) {
+		_, err := repo.FindById(ctx, "EMP-001")
+		expectErr(t, err, nil)
+
+		_, err = repo.FindById(ctx, "EMP-999")
+		expectErr(t, err, repository.ErrEmployeeNotFound)
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			employee models.Employee
+			wantErr  error
+		}{
+			{name: "new", employee: models.Employee{UserID: "new-uid", EmployeeName: "new", EmployeeCode: "EMP-002", IDRole: 1, WarehouseCode: "WH-01"}},
+			{name: "duplicate employee code", employee: models.Employee{UserID: "other-uid", EmployeeCode: "SA-001", IDRole: 1, WarehouseCode: "WH-01"}, wantErr: repository.ErrDuplicate},
+			{name: "duplicate user id", employee: models.Employee{UserID: "staff-uid", EmployeeCode: "EMP-003", IDRole: 1, WarehouseCode: "WH-01"}, wantErr: repository.ErrDuplicate},
+			{name: "unknown warehouse", employee: models.Employee{UserID: "x-uid", EmployeeCode: "EMP-004", IDRole: 1, WarehouseCode: "WH-99"}, wantErr: repository.ErrInvalidReference},
+			{name: "unknown role", employee: models.Employee{UserID: "y-uid", EmployeeCode: "EMP-005", IDRole: 99, WarehouseCode: "WH-01"}, wantErr: repository.ErrInvalidReference},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Save(ctx, &tt.employee), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			employee models.Employee
+			wantErr  error
+		}{
+			{name: "move warehouse", employee: models.Employee{EmployeeCode: "EMP-001", EmployeeName: "staff", IDRole: 2, WarehouseCode: "WH-03"}},
+			{name: "unknown warehouse", employee: models.Employee{EmployeeCode: "EMP-001", IDRole: 1, WarehouseCode: "WH-99"}, wantErr: repository.ErrInvalidReference},
+			{name: "not found", employee: models.Employee{EmployeeCode: "EMP-999", IDRole: 1, WarehouseCode: "WH-01"}, wantErr: repository.ErrEmployeeNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Update(ctx, &tt.employee), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("FindAll", func(t *testing.T) {
+		employees, page, err := repo.FindAll(ctx, listParams(map[string]string{"warehouse_code": "WH-01"}))
+		expectErr(t, err, nil)
+		// SA-001 dari seed dan EMP-002 dari subtest Save
+		if page.Total != 2 || len(employees) != 2 {
+			t.Fatalf("expected 2 employees in WH-01, got total %d: %+v", page.Total, employees)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		expectErr(t, repo.Delete(ctx, "EMP-002"), nil)
+		expectErr(t, repo.Delete(ctx, "EMP-999"), repository.ErrEmployeeNotFound)
+	})
+}
+
+func TestAuthRepository(t *testing.T) {
+	testAuthRepository(t, repository.NewAuthRepository(newTestDB(t)))
+}
+
+func TestMemoryAuthRepository(t *testing.T) {
+	testAuthRepository(t, memory.NewAuthRepository(newMemoryStore(t)))
+}
+
+// testAuthRepository menjalankan skenario yang sama untuk AuthRepository PostgreSQL dan in-memory
+func testAuthRepository(t *testing.T, repo repository.AuthRepository) {
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(time.Hour)
+	refreshToken := func(hash, employeeCode string) *models.RefreshToken {
+		return &models.RefreshToken{TokenHash: hash, EmployeeCode: employeeCode, FamilyID: "family-1", ExpiresAt: expiresAt}
+	}
+
+	first := refreshToken("hash-1", "EMP-001")
+
+	t.Run("SaveRefreshToken", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			token   *models.RefreshToken
+			wantErr error
+		}{
+			{name: "new", token: first},
+			{name: "unknown employee", token: refreshToken("hash-x", "EMP-999"), wantErr: repository.ErrEmployeeNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.SaveRefreshToken(ctx, tt.token), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("FindRefreshToken", func(t *testing.T) {
+		token, err := repo.FindRefreshToken(ctx, "hash-1")
+		expectErr(t, err, nil)
+		if token.ID != first.ID || token.RevokedAt != nil {
+			t.Fatalf("unexpected token: %+v", token)
+		}
+
+		_, err = repo.FindRefreshToken(ctx, "unknown")
+		expectErr(t, err, repository.ErrRefreshTokenNotFound)
+	})
+
+	t.Run("RotateRefreshToken", func(t *testing.T) {
+		second := refreshToken("hash-2", "EMP-001")
+		expectErr(t, repo.RotateRefreshToken(ctx, first.ID, second), nil)
+
+		// token lama dipakai lagi: rotasi ditolak dan token pengganti tidak tersimpan
+		expectErr(t, repo.RotateRefreshToken(ctx, first.ID, refreshToken("hash-3", "EMP-001")), repository.ErrRefreshTokenRevoked)
+		_, err := repo.FindRefreshToken(ctx, "hash-3")
+		expectErr(t, err, repository.ErrRefreshTokenNotFound)
+
+		old, err := repo.FindRefreshToken(ctx, "hash-1")
+		expectErr(t, err, nil)
+		if old.RevokedAt == nil || old.ReplacedBy == nil || *old.ReplacedBy != second.ID {
+			t.Fatalf("expected old token to be revoked and replaced by %d, got %+v", second.ID, old)
+		}
+	})
+
+	t.Run("RevokeRefreshFamily", func(t *testing.T) {
+		expectErr(t, repo.RevokeRefreshFamily(ctx, "family-1"), nil)
+
+		token, err := repo.FindRefreshToken(ctx, "hash-2")
+		expectErr(t, err, nil)
+		if token.RevokedAt == nil {
+			t.Fatal("expected every token in the family to be revoked")
+		}
+	})
+
+	t.Run("RevokeAccessToken", func(t *testing.T) {
+		// dicabut dua kali tetap berhasil
+		for range 2 {
+			expectErr(t, repo.RevokeAccessToken(ctx, "jti-1", expiresAt), nil)
+		}
+
+		for jti, want := range map[string]bool{"jti-1": true, "jti-2": false} {
+			revoked, err := repo.IsAccessTokenRevoked(ctx, jti)
+			expectErr(t, err, nil)
+			if revoked != want {
+				t.Fatalf("IsAccessTokenRevoked(%q): expected %v, got %v", jti, want, revoked)
+			}
+		}
+	})
+}
@@ -0,0 +1,157 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository/memory"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+)
+
+// id role hasil seed migration 000003
+const (
+	roleEmployee   = 1
+	roleManager    = 2
+	roleAdmin      = 3
+	roleSuperAdmin = 4
+)
+
+var (
+	adminClaims      = &auth.Claims{EmployeeCode: "ADM-001", IDRole: roleAdmin, Role: "admin", WarehouseCode: "WH-01"}
+	superAdminClaims = &auth.Claims{EmployeeCode: "SA-001", IDRole: roleSuperAdmin, Role: auth.RoleSuperAdmin, WarehouseCode: "WH-01"}
+)
+
+// loggedIn mengembalikan context seperti setelah middleware Authenticate dan WarehouseScope
+func loggedIn(claims *auth.Claims) context.Context {
+	ctx := auth.WithClaims(context.Background(), claims)
+	return auth.WithScope(ctx, auth.WarehouseScope{All: true})
+}
+
+func newEmployeeServices(store *memory.Store) service.EmployeeServices {
+	return service.NewEmployeeServices(
+		memory.NewEmployeeRepository(store),
+		service.NewRoleServices(memory.NewRoleRepository(store)),
+		service.NewAuditServices(memory.NewAuditRepository(store)),
+	)
+}
+
+func TestCreateEmployeeRoleAssignment(t *testing.T) {
+	tests := []struct {
+		name    string
+		claims  *auth.Claims
+		idRole  int
+		wantErr error
+	}{
+		{"admin assigns employee", adminClaims, roleEmployee, nil},
+		{"admin assigns admin", adminClaims, roleAdmin, nil},
+		{"admin cannot assign super admin", adminClaims, roleSuperAdmin, service.ErrRoleAssignmentForbidden},
+		{"unknown role", adminClaims, 99, repository.ErrRoleNotFound},
+		{"super admin assigns super admin", superAdminClaims, roleSuperAdmin, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			employees := newEmployeeServices(newMemoryStore(t))
+
+			err := employees.CreateEmployee(loggedIn(tt.claims), &request.CreateEmployee{
+				EmployeeName: "new hire", Password: "password123", IDRole: tt.idRole, WarehouseCode: "WH-02",
+			})
+			expectErr(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("no login", func(t *testing.T) {
+		employees := newEmployeeServices(newMemoryStore(t))
+
+		err := employees.CreateEmployee(auth.WithScope(context.Background(), auth.WarehouseScope{All: true}), &request.CreateEmployee{
+			EmployeeName: "new hire", Password: "password123", IDRole: roleEmployee, WarehouseCode: "WH-02",
+		})
+		expectErr(t, err, service.ErrUnauthenticated)
+	})
+}
+
+func TestUpdateEmployeeRoleAssignment(t *testing.T) {
+	password := "password123"
+
+	tests := []struct {
+		name     string
+		claims   *auth.Claims
+		code     string
+		req      request.UpdatedEmployee
+		wantErr  error
+		wantRole uint
+	}{
+		{"admin promotes to manager", adminClaims, "EMP-001", request.UpdatedEmployee{IDRole: roleManager}, nil, roleManager},
+		{"admin cannot promote to super admin", adminClaims, "EMP-001", request.UpdatedEmployee{IDRole: roleSuperAdmin}, service.ErrRoleAssignmentForbidden, roleEmployee},
+		// SA-001 adalah super admin dari data awal
+		{"admin cannot change super admin password", adminClaims, "SA-001", request.UpdatedEmployee{Password: &password}, service.ErrRoleAssignmentForbidden, roleSuperAdmin},
+		{"super admin promotes to super admin", superAdminClaims, "EMP-001", request.UpdatedEmployee{IDRole: roleSuperAdmin}, nil, roleSuperAdmin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemoryStore(t)
+			repo := memory.NewEmployeeRepository(store)
+
+			err := newEmployeeServices(store).UpdateEmployee(loggedIn(tt.claims), tt.code, &tt.req)
+			expectErr(t, err, tt.wantErr)
+
+			employee, err := repo.FindById(context.Background(), tt.code)
+			expectErr(t, err, nil)
+			if employee.IDRole != tt.wantRole {
+				t.Fatalf("expected role %d, got %d", tt.wantRole, employee.IDRole)
+			}
+		})
+	}
+}
+
+func TestEmployeeServicesWithoutScope(t *testing.T) {
+	// context tanpa scope warehouse (misalnya route tanpa ScopeWarehouse) tidak
+	// boleh mengakses warehouse mana pun
+	ctx := auth.WithClaims(context.Background(), superAdminClaims)
+	employees := newEmployeeServices(newMemoryStore(t))
+
+	_, _, err := employees.GetAllEmployee(ctx, listParams(nil))
+	expectErr(t, err, service.ErrWarehouseForbidden)
+
+	_, err = employees.GetEmployeeById(ctx, "EMP-001")
+	expectErr(t, err, service.ErrWarehouseForbidden)
+
+	err = employees.CreateEmployee(ctx, &request.CreateEmployee{
+		EmployeeName: "new hire", Password: "password123", IDRole: roleEmployee, WarehouseCode: "WH-02",
+	})
+	expectErr(t, err, service.ErrWarehouseForbidden)
+}
+
+func TestDeleteEmployeeRoleAssignment(t *testing.T) {
+	tests := []struct {
+		name    string
+		claims  *auth.Claims
+		code    string
+		wantErr error
+	}{
+		{"admin deletes employee", adminClaims, "EMP-001", nil},
+		// SA-001 adalah super admin dari data awal
+		{"admin cannot delete super admin", adminClaims, "SA-001", service.ErrRoleAssignmentForbidden},
+		{"super admin deletes employee", superAdminClaims, "EMP-001", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemoryStore(t)
+
+			err := newEmployeeServices(store).DeleteEmployee(loggedIn(tt.claims), tt.code)
+			expectErr(t, err, tt.wantErr)
+
+			_, err = memory.NewEmployeeRepository(store).FindById(context.Background(), tt.code)
+			if tt.wantErr == nil {
+				expectErr(t, err, repository.ErrEmployeeNotFound)
+			} else {
+				expectErr(t, err, nil)
+			}
+		})
+	}
+}
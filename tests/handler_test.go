@@ -0,0 +1,181 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/handler"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository/memory"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/validation"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+var registerValidation sync.Once
+
+// newTestRouter menyusun service dan handler di atas repository in-memory tanpa
+// auth, sehingga test handler berjalan tanpa database maupun token
+func newTestRouter(t *testing.T, store *memory.Store) *gin.Engine {
+	t.Helper()
+
+	registerValidation.Do(func() {
+		gin.SetMode(gin.TestMode)
+		if err := validation.Register(binding.Validator.Engine().(*validator.Validate)); err != nil {
+			t.Fatalf("register validation: %v", err)
+		}
+	})
+
+	audit := service.NewAuditServices(memory.NewAuditRepository(store))
+	warehouse := handler.NewWarehouseHandler(service.NewWarehouseServices(memory.NewWarehouseRepository(store), audit))
+	category := handler.NewCategoryHandler(service.NewCategoryServices(memory.NewCategoryRepository(store), audit))
+	employee := handler.NewEmployeeHandler(newEmployeeServices(store))
+	inventory := handler.NewInventoryHandler(service.NewInventoryServices(
+		memory.NewInventoryRepository(store), memory.NewProductDetailRepository(store), audit, memory.NewTxManager(store)))
+
+	r := gin.New()
+	// route test tidak memakai Authenticate dan ScopeWarehouse, jadi scope semua
+	// warehouse dipasang secara eksplisit seperti pemanggil internal
+	r.Use(middleware.ErrorHandler(), func(c *gin.Context) {
+		c.Request = c.Request.WithContext(auth.WithScope(c.Request.Context(), auth.WarehouseScope{All: true}))
+	})
+
+	r.GET("/warehouses", warehouse.HandlerGetAllWarehouse)
+	r.POST("/warehouses", warehouse.HandlerCreateWarehouse)
+	r.PATCH("/warehouses/:id", warehouse.HandlerUpdateWarehouse)
+	r.DELETE("/warehouses/:id", warehouse.HandlerDeleteWarehouse)
+	r.GET("/employees/by-warehouse/:id", employee.HandlerGetAllEmployeeByWarehouse)
+	r.PATCH("/employees/:id", employee.HandlerUpdateEmployee)
+	r.DELETE("/employees/:id", employee.HandlerDeleteEmployee)
+	r.GET("/category", category.HandlerGetAllCategory)
+	r.DELETE("/category/:id", category.HandlerDeleteCategory)
+	r.POST("/inventory/adjustments", inventory.HandlerAdjustStock)
+	r.GET("/audit", handler.NewAuditHandler(audit).HandlerGetAllAudit)
+
+	return r
+}
+
+// serve mengirim body sebagai JSON; body string dikirim apa adanya
+func serve(t *testing.T, r http.Handler, method, target string, body any) (int, response.ApiResponse) {
+	t.Helper()
+
+	var reader bytes.Buffer
+	if raw, ok := body.(string); ok {
+		reader.WriteString(raw)
+	} else if body != nil {
+		if err := json.NewEncoder(&reader).Encode(body); err != nil {
+			t.Fatalf("encode body: %v", err)
+		}
+	}
+
+	req := httptest.NewRequest(method, target, &reader)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	var resp response.ApiResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response %q: %v", rec.Body.String(), err)
+	}
+	return rec.Code, resp
+}
+
+func TestHandlersWithMemoryRepository(t *testing.T) {
+	r := newTestRouter(t, newMemoryStore(t))
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       any
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "list warehouses", method: http.MethodGet, target: "/warehouses?sort=-code", wantStatus: http.StatusOK},
+		{name: "unknown sort", method: http.MethodGet, target: "/warehouses?sort=location", wantStatus: http.StatusBadRequest, wantCode: "invalid_sort"},
+		{name: "unknown filter", method: http.MethodGet, target: "/category?color=red", wantStatus: http.StatusBadRequest, wantCode: "invalid_filter"},
+		{
+			name: "create warehouse", method: http.MethodPost, target: "/warehouses",
+			body:       map[string]any{"warehouse_name": "Bandung", "location_description": "Jl. Asia Afrika No. 8, Bandung"},
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name: "duplicate warehouse name", method: http.MethodPost, target: "/warehouses",
+			body:       map[string]any{"warehouse_name": "Bandung", "location_description": "Jl. Asia Afrika No. 8, Bandung"},
+			wantStatus: http.StatusConflict, wantCode: "duplicate",
+		},
+		{
+			name: "invalid warehouse body", method: http.MethodPost, target: "/warehouses",
+			body:       map[string]any{"warehouse_name": "WH"},
+			wantStatus: http.StatusUnprocessableEntity, wantCode: "validation_failed",
+		},
+		{
+			name: "update seeded warehouse", method: http.MethodPatch, target: "/warehouses/WH-01",
+			body:       map[string]any{"warehouse_name": "Gudang Utama"},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "update invalid warehouse code", method: http.MethodPatch, target: "/warehouses/gudang-1",
+			body:       map[string]any{"warehouse_name": "Gudang Utama"},
+			wantStatus: http.StatusBadRequest, wantCode: "invalid_warehouse_code",
+		},
+		{
+			name: "update warehouse with invalid json", method: http.MethodPatch, target: "/warehouses/WH-01",
+			body:       `{"warehouse_name":`,
+			wantStatus: http.StatusBadRequest, wantCode: "invalid_json",
+		},
+		{name: "delete seeded warehouse", method: http.MethodDelete, target: "/warehouses/WH-03", wantStatus: http.StatusOK},
+		{name: "delete warehouse not found", method: http.MethodDelete, target: "/warehouses/WH-09", wantStatus: http.StatusNotFound, wantCode: "warehouse_not_found"},
+		{name: "delete invalid warehouse code", method: http.MethodDelete, target: "/warehouses/gudang-1", wantStatus: http.StatusBadRequest, wantCode: "invalid_warehouse_code"},
+		{name: "employees of seeded warehouse", method: http.MethodGet, target: "/employees/by-warehouse/WH-02", wantStatus: http.StatusOK},
+		{name: "employees of invalid warehouse code", method: http.MethodGet, target: "/employees/by-warehouse/gudang-2", wantStatus: http.StatusBadRequest, wantCode: "invalid_warehouse_code"},
+		// route test tidak membawa claims, jadi kode seed yang valid berhenti di cek login
+		{
+			name: "update seeded employee", method: http.MethodPatch, target: "/employees/SA-001",
+			body:       map[string]any{"password": "password-baru"},
+			wantStatus: http.StatusUnauthorized, wantCode: "unauthenticated",
+		},
+		{name: "delete invalid employee code", method: http.MethodDelete, target: "/employees/sa-001", wantStatus: http.StatusBadRequest, wantCode: "invalid_employee_code"},
+		{name: "category in use", method: http.MethodDelete, target: "/category/1", wantStatus: http.StatusConflict, wantCode: "still_referenced"},
+		{name: "category not found", method: http.MethodDelete, target: "/category/99", wantStatus: http.StatusNotFound, wantCode: "category_not_found"},
+		{
+			name: "adjust stock", method: http.MethodPost, target: "/inventory/adjustments",
+			body:       map[string]any{"code_product": "PRD-001", "id_size": 1, "code_warehouse": "WH-01", "quantity": -4},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "adjust below zero", method: http.MethodPost, target: "/inventory/adjustments",
+			body:       map[string]any{"code_product": "PRD-001", "id_size": 1, "code_warehouse": "WH-01", "quantity": -7},
+			wantStatus: http.StatusConflict, wantCode: "insufficient_stock",
+		},
+		{
+			name: "adjust unknown variant", method: http.MethodPost, target: "/inventory/adjustments",
+			body:       map[string]any{"code_product": "PRD-002", "id_size": 1, "code_warehouse": "WH-01", "quantity": 1},
+			wantStatus: http.StatusNotFound, wantCode: "product_variant_not_found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, resp := serve(t, r, tt.method, tt.target, tt.body)
+			if status != tt.wantStatus || resp.Code != tt.wantCode {
+				t.Fatalf("expected %d %q, got %d %q (%s)", tt.wantStatus, tt.wantCode, status, resp.Code, resp.Message)
+			}
+		})
+	}
+
+	t.Run("audit records successful changes only", func(t *testing.T) {
+		status, resp := serve(t, r, http.MethodGet, "/audit", nil)
+		if status != http.StatusOK || resp.Meta == nil || resp.Meta.Total != 4 {
+			t.Fatalf("expected warehouse create, update, delete and stock adjustment in audit, got %d %+v", status, resp.Meta)
+		}
+	})
+}
@@ -0,0 +1,232 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/migrate"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/migrations"
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	_ "github.com/lib/pq"
+)
+
+// Integration test repository dijalankan terhadap PostgreSQL sekali pakai:
+//
+//   - WMS_TEST_DSN diisi: memakai server yang sudah ada. User-nya harus boleh
+//     CREATE DATABASE; database test dibuat dan dihapus sendiri oleh harness.
+//   - WMS_TEST_DSN kosong: menjalankan embedded-postgres di direktori sementara.
+//     Binary PostgreSQL diunduh sekali lalu disimpan di cache ~/.embedded-postgres-go.
+//
+// Jika PostgreSQL tidak bisa disiapkan (tidak ada jaringan, berjalan sebagai root, dsb.)
+// semua test yang butuh database di-skip, kecuali WMS_REQUIRE_DB=1. CI selalu mengisi
+// WMS_REQUIRE_DB=1 (.github/workflows/ci.yml) supaya test database tidak hilang diam-diam.
+//
+// PostgreSQL baru dijalankan saat test pertama memanggil newTestDB, jadi test yang
+// memakai repository in-memory tidak ikut menunggu. Schema dan fixture disiapkan satu
+// kali di database template; setiap test mendapat database baru hasil
+// CREATE DATABASE ... TEMPLATE sehingga test tidak saling memengaruhi.
+const (
+	testDSNEnv       = "WMS_TEST_DSN"
+	testRequireDBEnv = "WMS_REQUIRE_DB"
+
+	testDBUser     = "wms"
+	testDBPassword = "wms"
+)
+
+var harness struct {
+	// adminDSN terhubung ke database yang boleh membuat database lain
+	adminDSN string
+	template string
+	admin    *sql.DB
+	pg       *embeddedpostgres.EmbeddedPostgres
+
+	// setup dijalankan sekali; err berisi alasan jika PostgreSQL tidak tersedia
+	setup sync.Once
+	err   error
+
+	// CREATE DATABASE ... TEMPLATE gagal jika template sedang dipakai clone lain
+	mu      sync.Mutex
+	counter atomic.Int64
+}
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+
+	teardownHarness()
+	os.Exit(code)
+}
+
+func setupHarness() error {
+	harness.adminDSN = os.Getenv(testDSNEnv)
+	if harness.adminDSN == "" {
+		if err := startEmbeddedPostgres(); err != nil {
+			return err
+		}
+	}
+
+	admin, err := sql.Open("postgres", harness.adminDSN)
+	if err != nil {
+		return err
+	}
+	harness.admin = admin
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if err := admin.PingContext(ctx); err != nil {
+		return fmt.Errorf("ping %s: %w", testDSNEnv, err)
+	}
+
+	harness.template = fmt.Sprintf("wms_template_%d", os.Getpid())
+	if _, err := admin.ExecContext(ctx, `CREATE DATABASE `+harness.template); err != nil {
+		return fmt.Errorf("create template database: %w", err)
+	}
+
+	return prepareTemplate(ctx)
+}
+
+// startEmbeddedPostgres menjalankan PostgreSQL di port kosong dengan data di direktori sementara
+func startEmbeddedPostgres() error {
+	dir, err := os.MkdirTemp("", "wms-postgres-")
+	if err != nil {
+		return err
+	}
+
+	port, err := freePort()
+	if err != nil {
+		return err
+	}
+
+	// log PostgreSQL hanya ditampilkan jika start gagal
+	var logs bytes.Buffer
+	pg := embeddedpostgres.NewDatabase(embeddedpostgres.DefaultConfig().
+		Port(port).
+		Username(testDBUser).
+		Password(testDBPassword).
+		Database("postgres").
+		RuntimePath(filepath.Join(dir, "runtime")).
+		DataPath(filepath.Join(dir, "data")).
+		StartTimeout(time.Minute).
+		Logger(&logs))
+
+	if err := pg.Start(); err != nil {
+		os.RemoveAll(dir)
+		return fmt.Errorf("start embedded postgres: %w\n%s", err, logs.String())
+	}
+
+	harness.pg = pg
+	harness.adminDSN = fmt.Sprintf("host=localhost port=%d user=%s password=%s dbname=postgres sslmode=disable",
+		port, testDBUser, testDBPassword)
+	return nil
+}
+
+// prepareTemplate menjalankan semua migration lalu fixture di database template
+func prepareTemplate(ctx context.Context) error {
+	db, err := sql.Open("postgres", dsnWithDatabase(harness.adminDSN, harness.template))
+	if err != nil {
+		return err
+	}
+	// koneksi ke template harus ditutup sebelum template bisa di-clone
+	defer db.Close()
+
+	migrator, err := migrate.New(db, migrations.FS)
+	if err != nil {
+		return err
+	}
+	if _, err := migrator.Up(ctx, 0); err != nil {
+		return fmt.Errorf("migrate template database: %w", err)
+	}
+
+	fixtures, err := os.ReadFile(filepath.Join("testdata", "fixtures.sql"))
+	if err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, string(fixtures)); err != nil {
+		return fmt.Errorf("load fixtures: %w", err)
+	}
+
+	return nil
+}
+
+func teardownHarness() {
+	if harness.admin != nil {
+		if harness.template != "" {
+			harness.admin.Exec(`DROP DATABASE IF EXISTS ` + harness.template)
+		}
+		harness.admin.Close()
+	}
+	if harness.pg != nil {
+		harness.pg.Stop()
+	}
+}
+
+// newTestDB mengembalikan database baru berisi schema dan fixture yang dihapus
+// setelah test selesai. Test di-skip jika PostgreSQL tidak tersedia, atau gagal
+// jika WMS_REQUIRE_DB diisi.
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	harness.setup.Do(func() { harness.err = setupHarness() })
+	if harness.err != nil {
+		if required, _ := strconv.ParseBool(os.Getenv(testRequireDBEnv)); required {
+			t.Fatalf("integration database is required: %v", harness.err)
+		}
+		t.Skipf("integration database unavailable: %v", harness.err)
+	}
+
+	name := fmt.Sprintf("wms_test_%d_%d", os.Getpid(), harness.counter.Add(1))
+
+	harness.mu.Lock()
+	_, err := harness.admin.Exec(`CREATE DATABASE ` + name + ` TEMPLATE ` + harness.template)
+	harness.mu.Unlock()
+	if err != nil {
+		t.Fatalf("create test database: %v", err)
+	}
+
+	db, err := sql.Open("postgres", dsnWithDatabase(harness.adminDSN, name))
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+
+	t.Cleanup(func() {
+		db.Close()
+		if _, err := harness.admin.Exec(`DROP DATABASE IF EXISTS ` + name); err != nil {
+			t.Logf("drop test database %s: %v", name, err)
+		}
+	})
+
+	return db
+}
+
+// dsnWithDatabase mengganti nama database pada DSN format URL maupun key=value
+func dsnWithDatabase(dsn, name string) string {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		if u, err := url.Parse(dsn); err == nil {
+			u.Path = "/" + name
+			return u.String()
+		}
+	}
+	// lib/pq memakai nilai terakhir jika key yang sama muncul dua kali
+	return dsn + " dbname=" + name
+}
+
+func freePort() (uint32, error) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return uint32(l.Addr().(*net.TCPAddr).Port), nil
+}
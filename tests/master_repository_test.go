@@ -0,0 +1,333 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+// expectErr membandingkan error dengan errors.Is; want nil berarti tidak boleh ada error
+func expectErr(t *testing.T, got, want error) {
+	t.Helper()
+
+	switch {
+	case want == nil && got != nil:
+		t.Fatalf("unexpected error: %v", got)
+	case want != nil && !errors.Is(got, want):
+		t.Fatalf("expected error %v, got %v", want, got)
+	}
+}
+
+func listParams(filters map[string]string) pagination.Params {
+	return pagination.Params{Limit: pagination.DefaultLimit, Filters: filters}
+}
+
+func TestCategoryRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := repository.NewCategoryRepository(db)
+	ctx := context.Background()
+
+	t.Run("FindById", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			id       int
+			wantName string
+			wantErr  error
+		}{
+			{name: "seed data", id: 1, wantName: "shirt"},
+			{name: "not found", id: 99, wantErr: repository.ErrCategoryNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				category, err := repo.FindById(ctx, tt.id)
+				expectErr(t, err, tt.wantErr)
+				if tt.wantErr == nil && category.Name != tt.wantName {
+					t.Fatalf("expected name %q, got %q", tt.wantName, category.Name)
+				}
+			})
+		}
+	})
+
+	t.Run("Save and Update", func(t *testing.T) {
+		category := &models.Category{Name: "hats"}
+		expectErr(t, repo.Save(ctx, category), nil)
+		if category.ID == 0 {
+			t.Fatal("expected Save to fill the new id")
+		}
+
+		category.Name = "caps"
+		expectErr(t, repo.Update(ctx, category), nil)
+		expectErr(t, repo.Update(ctx, &models.Category{ID: 99, Name: "ghost"}), repository.ErrCategoryNotFound)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			id      int
+			wantErr error
+		}{
+			{name: "still used by product", id: 1, wantErr: repository.ErrStillReferenced},
+			{name: "unused", id: 3},
+			{name: "not found", id: 99, wantErr: repository.ErrCategoryNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Delete(ctx, tt.id), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("FindAll", func(t *testing.T) {
+		categories, page, err := repo.FindAll(ctx, listParams(map[string]string{"name": "PAN"}))
+		expectErr(t, err, nil)
+		if page.Total != 1 || len(categories) != 1 || categories[0].Name != "pants" {
+			t.Fatalf("expected only pants, got total %d: %+v", page.Total, categories)
+		}
+
+		_, _, err = repo.FindAll(ctx, listParams(map[string]string{"unknown": "x"}))
+		expectErr(t, err, pagination.ErrInvalidFilter)
+	})
+}
+
+func TestSizeRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := repository.NewSizeRepository(db)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		run     func() error
+		wantErr error
+	}{
+		{name: "find seed data", run: func() error { _, err := repo.FindById(ctx, 1); return err }},
+		{name: "find not found", run: func() error { _, err := repo.FindById(ctx, 99); return err }, wantErr: repository.ErrSizeNotFound},
+		{name: "update not found", run: func() error { return repo.Update(ctx, &models.Size{ID: 99, Name: "3XL"}) }, wantErr: repository.ErrSizeNotFound},
+		{name: "delete still used by variant", run: func() error { return repo.Delete(ctx, 1) }, wantErr: repository.ErrStillReferenced},
+		{name: "delete unused", run: func() error { return repo.Delete(ctx, 5) }},
+		{name: "delete not found", run: func() error { return repo.Delete(ctx, 99) }, wantErr: repository.ErrSizeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectErr(t, tt.run(), tt.wantErr)
+		})
+	}
+}
+
+func TestWarehouseRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := repository.NewWarehouseRepository(db)
+	ctx := context.Background()
+
+	t.Run("FindById", func(t *testing.T) {
+		warehouse, err := repo.FindById(ctx, "WH-01")
+		expectErr(t, err, nil)
+		if warehouse.WarehouseCode != "WH-01" || warehouse.LocationDescription != "" {
+			t.Fatalf("unexpected warehouse: %+v", warehouse)
+		}
+
+		_, err = repo.FindById(ctx, "WH-99")
+		expectErr(t, err, repository.ErrWarehouseNotFound)
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			warehouse models.Warehouse
+			wantErr   error
+		}{
+			{name: "new", warehouse: models.Warehouse{WarehouseName: "Surabaya", WarehouseCode: "WH-SBY", LocationDescription: "Jl. Raya 1"}},
+			{name: "duplicate code", warehouse: models.Warehouse{WarehouseName: "Other", WarehouseCode: "WH-01"}, wantErr: repository.ErrDuplicate},
+			{name: "duplicate name", warehouse: models.Warehouse{WarehouseName: "WH-02", WarehouseCode: "WH-NEW"}, wantErr: repository.ErrDuplicate},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Save(ctx, &tt.warehouse), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			code    string
+			fields  map[string]any
+			wantErr error
+		}{
+			{name: "location", code: "WH-01", fields: map[string]any{"location_description": "Jakarta"}},
+			{name: "column not allowed", code: "WH-01", fields: map[string]any{"warehouse_code": "WH-X"}, wantErr: repository.ErrNoFieldsToUpdate},
+			{name: "only empty values", code: "WH-01", fields: map[string]any{"warehouse_name": ""}, wantErr: repository.ErrNoFieldsToUpdate},
+			{name: "duplicate name", code: "WH-01", fields: map[string]any{"warehouse_name": "WH-02"}, wantErr: repository.ErrDuplicate},
+			{name: "not found", code: "WH-99", fields: map[string]any{"warehouse_name": "Ghost"}, wantErr: repository.ErrWarehouseNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Update(ctx, tt.fields, tt.code), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			code    string
+			wantErr error
+		}{
+			{name: "still used by employee", code: "WH-02", wantErr: repository.ErrStillReferenced},
+			{name: "unused", code: "WH-03"},
+			{name: "not found", code: "WH-99", wantErr: repository.ErrWarehouseNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Delete(ctx, tt.code), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("ExistsByCode", func(t *testing.T) {
+		for code, want := range map[string]bool{"WH-01": true, "WH-99": false} {
+			exists, err := repo.ExistsByCode(ctx, code)
+			expectErr(t, err, nil)
+			if exists != want {
+				t.Fatalf("ExistsByCode(%q): expected %v, got %v", code, want, exists)
+			}
+		}
+	})
+
+	t.Run("FindAll", func(t *testing.T) {
+		warehouses, page, err := repo.FindAll(ctx, listParams(map[string]string{"code": "WH-02"}))
+		expectErr(t, err, nil)
+		if page.Total != 1 || len(warehouses) != 1 || warehouses[0].WarehouseCode != "WH-02" {
+			t.Fatalf("expected only WH-02, got total %d: %+v", page.Total, warehouses)
+		}
+	})
+}
+
+func TestRoleRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := repository.NewRoleRepository(db)
+	ctx := context.Background()
+
+	t.Run("FindById", func(t *testing.T) {
+		role, err := repo.FindById(ctx, 4)
+		expectErr(t, err, nil)
+		if role.RoleName != "super admin" || !slices.Contains(role.Permissions, "role:write") {
+			t.Fatalf("unexpected role: %+v", role)
+		}
+
+		_, err = repo.FindById(ctx, 99)
+		expectErr(t, err, repository.ErrRoleNotFound)
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			role    models.Role
+			wantErr error
+		}{
+			{name: "new role with permissions", role: models.Role{RoleName: "auditor", Permissions: []string{"audit:read"}}},
+			{name: "duplicate name", role: models.Role{RoleName: "manager"}, wantErr: repository.ErrRoleNameExists},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Save(ctx, &tt.role), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("Update and SetPermissions", func(t *testing.T) {
+		expectErr(t, repo.Update(ctx, &models.Role{ID: 2, RoleName: "admin"}), repository.ErrRoleNameExists)
+		expectErr(t, repo.Update(ctx, &models.Role{ID: 99, RoleName: "ghost"}), repository.ErrRoleNotFound)
+
+		expectErr(t, repo.SetPermissions(ctx, 2, []string{"master:read"}), nil)
+		expectErr(t, repo.SetPermissions(ctx, 99, []string{"master:read"}), repository.ErrRoleNotFound)
+
+		permissions, err := repo.FindAllPermissions(ctx)
+		expectErr(t, err, nil)
+		if got := permissions[2]; len(got) != 1 || got[0] != "master:read" {
+			t.Fatalf("expected manager permissions to be replaced, got %v", got)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			id      int
+			wantErr error
+		}{
+			{name: "still assigned to employee", id: 4, wantErr: repository.ErrRoleInUse},
+			{name: "unused", id: 3},
+			{name: "not found", id: 99, wantErr: repository.ErrRoleNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Delete(ctx, tt.id), tt.wantErr)
+			})
+		}
+	})
+}
+
+func TestAuditRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := repository.NewAuditRepository(db)
+	ctx := context.Background()
+
+	entries := []*models.AuditLog{
+		{ActorEmployeeCode: "SA-001", EntityType: "warehouse", EntityKey: "WH-01", Action: "update",
+			Before: json.RawMessage(`{"warehouse_name":"WH-01"}`), After: json.RawMessage(`{"warehouse_name":"Jakarta"}`),
+			Diff: json.RawMessage(`{"warehouse_name":{"before":"WH-01","after":"Jakarta"}}`), RequestID: "req-1"},
+		{EntityType: "category", EntityKey: "4", Action: "create", After: json.RawMessage(`{"name":"hats"}`), Diff: json.RawMessage(`{}`)},
+	}
+	for _, entry := range entries {
+		expectErr(t, repo.Save(ctx, entry), nil)
+		if entry.ID == 0 || entry.CreatedAt.IsZero() {
+			t.Fatalf("expected Save to fill id and created_at, got %+v", entry)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		filters   map[string]string
+		wantTotal int64
+		wantErr   error
+	}{
+		{name: "all", filters: map[string]string{}, wantTotal: 2},
+		{name: "by entity", filters: map[string]string{"entity_type": "warehouse", "entity_key": "WH-01"}, wantTotal: 1},
+		{name: "by actor", filters: map[string]string{"actor": "SA-001"}, wantTotal: 1},
+		{name: "invalid since", filters: map[string]string{"since": "yesterday"}, wantErr: pagination.ErrInvalidFilter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs, page, err := repo.FindAll(ctx, listParams(tt.filters))
+			expectErr(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			if page.Total != tt.wantTotal || int64(len(logs)) != tt.wantTotal {
+				t.Fatalf("expected %d entries, got total %d and %d rows", tt.wantTotal, page.Total, len(logs))
+			}
+		})
+	}
+
+	t.Run("empty snapshot stays null", func(t *testing.T) {
+		logs, _, err := repo.FindAll(ctx, listParams(map[string]string{"entity_type": "category"}))
+		expectErr(t, err, nil)
+		if len(logs) != 1 || len(logs[0].Before) != 0 {
+			t.Fatalf("expected create entry without before snapshot, got %+v", logs)
+		}
+	})
+}
@@ -0,0 +1,426 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository/memory"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/apperror"
+)
+
+// newMemoryStore mengembalikan Store in-memory berisi data yang sama dengan testdata/fixtures.sql
+func newMemoryStore(t *testing.T) *memory.Store {
+	t.Helper()
+
+	store := memory.NewStore()
+	ctx := context.Background()
+
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("load memory fixtures: %v", err)
+		}
+	}
+
+	must(memory.NewEmployeeRepository(store).Save(ctx, &models.Employee{
+		UserID: "staff-uid", EmployeeName: "staff", Password: "not-a-real-hash",
+		EmployeeCode: "EMP-001", IDRole: 1, WarehouseCode: "WH-02",
+	}))
+
+	products := memory.NewProductRepository(store)
+	must(products.Save(ctx, &models.Product{ProductName: "Basic Tee", Price: 99000, DescriptionProduct: "Cotton t-shirt", ProductCode: "PRD-001", IDCategory: 1}))
+	must(products.Save(ctx, &models.Product{ProductName: "Chino", Price: 249000, ProductCode: "PRD-002", IDCategory: 2}))
+
+	details := memory.NewProductDetailRepository(store)
+	must(details.Save(ctx, &models.ProductDetail{CodeProduct: "PRD-001", IDSize: 1, Barcode: "4006381333931"}))
+	must(details.Save(ctx, &models.ProductDetail{CodeProduct: "PRD-001", IDSize: 2, Barcode: "4006381333948"}))
+	must(details.Save(ctx, &models.ProductDetail{CodeProduct: "PRD-002", IDSize: 3, Barcode: "4006381333955"}))
+
+	inventories := memory.NewInventoryRepository(store)
+	for _, stock := range []struct {
+		product   string
+		size      int
+		warehouse string
+		quantity  int
+	}{
+		{"PRD-001", 1, "WH-01", 10},
+		{"PRD-001", 2, "WH-01", 5},
+		{"PRD-002", 3, "WH-02", 7},
+	} {
+		_, err := inventories.Adjust(ctx, stock.product, stock.size, stock.warehouse, stock.quantity)
+		must(err)
+	}
+
+	return store
+}
+
+func TestMemoryMasterRepository(t *testing.T) {
+	store := newMemoryStore(t)
+	ctx := context.Background()
+
+	categories := memory.NewCategoryRepository(store)
+	sizes := memory.NewSizeRepository(store)
+	warehouses := memory.NewWarehouseRepository(store)
+
+	t.Run("Category", func(t *testing.T) {
+		_, err := categories.FindById(ctx, 99)
+		expectErr(t, err, repository.ErrCategoryNotFound)
+
+		hats := &models.Category{Name: "hats"}
+		expectErr(t, categories.Save(ctx, hats), nil)
+		if hats.ID != 4 {
+			t.Fatalf("expected id 4 after seed data, got %d", hats.ID)
+		}
+
+		// category shirt masih dipakai PRD-001
+		expectErr(t, categories.Delete(ctx, 1), repository.ErrStillReferenced)
+		expectErr(t, categories.Delete(ctx, int(hats.ID)), nil)
+		expectErr(t, categories.Delete(ctx, int(hats.ID)), repository.ErrCategoryNotFound)
+	})
+
+	t.Run("Size", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			id      int
+			wantErr error
+		}{
+			{name: "used by variant", id: 1, wantErr: repository.ErrStillReferenced},
+			{name: "unused", id: 5},
+			{name: "not found", id: 99, wantErr: repository.ErrSizeNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, sizes.Delete(ctx, tt.id), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("Warehouse", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			warehouse models.Warehouse
+			wantErr   error
+		}{
+			{name: "new", warehouse: models.Warehouse{WarehouseName: "Bandung", WarehouseCode: "WH-04", LocationDescription: "Jl. Asia Afrika"}},
+			{name: "duplicate name", warehouse: models.Warehouse{WarehouseName: "Bandung", WarehouseCode: "WH-05"}, wantErr: repository.ErrDuplicate},
+			{name: "duplicate code", warehouse: models.Warehouse{WarehouseName: "Bogor", WarehouseCode: "WH-01"}, wantErr: repository.ErrDuplicate},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, warehouses.Save(ctx, &tt.warehouse), tt.wantErr)
+			})
+		}
+
+		expectErr(t, warehouses.Update(ctx, map[string]any{"warehouse_code": "WH-09"}, "WH-04"), repository.ErrNoFieldsToUpdate)
+		expectErr(t, warehouses.Update(ctx, map[string]any{"warehouse_name": ""}, "WH-04"), repository.ErrNoFieldsToUpdate)
+		expectErr(t, warehouses.Update(ctx, map[string]any{"warehouse_name": "WH-01"}, "WH-04"), repository.ErrDuplicate)
+		expectErr(t, warehouses.Update(ctx, map[string]any{"warehouse_name": "Ghost"}, "WH-99"), repository.ErrWarehouseNotFound)
+
+		// WH-02 masih dipakai EMP-001; WH-03 tidak dipakai employee
+		expectErr(t, warehouses.Delete(ctx, "WH-02"), repository.ErrStillReferenced)
+		expectErr(t, warehouses.Delete(ctx, "WH-03"), nil)
+
+		found, page, err := warehouses.FindAll(ctx, listParams(map[string]string{"location": "asia"}))
+		expectErr(t, err, nil)
+		if page.Total != 1 || found[0].WarehouseCode != "WH-04" {
+			t.Fatalf("expected WH-04 only, got total %d: %+v", page.Total, found)
+		}
+	})
+}
+
+func TestMemoryEmployeeRepository(t *testing.T) {
+	repo := memory.NewEmployeeRepository(newMemoryStore(t))
+	ctx := context.Background()
+
+	t.Run("Save", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			employee models.Employee
+			wantErr  error
+		}{
+			{name: "new", employee: models.Employee{UserID: "new-uid", EmployeeCode: "EMP-002", IDRole: 1, WarehouseCode: "WH-01"}},
+			{name: "duplicate user id", employee: models.Employee{UserID: "staff-uid", EmployeeCode: "EMP-003", IDRole: 1, WarehouseCode: "WH-01"}, wantErr: repository.ErrDuplicate},
+			{name: "unknown role", employee: models.Employee{UserID: "x-uid", EmployeeCode: "EMP-004", IDRole: 99, WarehouseCode: "WH-01"}, wantErr: repository.ErrInvalidReference},
+			{name: "unknown warehouse", employee: models.Employee{UserID: "y-uid", EmployeeCode: "EMP-005", IDRole: 1, WarehouseCode: "WH-99"}, wantErr: repository.ErrInvalidReference},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Save(ctx, &tt.employee), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("FindByLogin", func(t *testing.T) {
+		emp, err := repo.FindByLogin(ctx, "staff-uid")
+		expectErr(t, err, nil)
+		if emp.EmployeeCode != "EMP-001" || emp.Role.RoleName != "employee" || emp.Password == "" {
+			t.Fatalf("unexpected employee: %+v", emp)
+		}
+
+		_, err = repo.FindByLogin(ctx, "nobody")
+		expectErr(t, err, repository.ErrEmployeeNotFound)
+	})
+
+	t.Run("FindAll", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			filters   map[string]string
+			wantTotal int64
+			wantErr   error
+		}{
+			{name: "by warehouse", filters: map[string]string{"warehouse_code": "WH-01"}, wantTotal: 2},
+			{name: "by role", filters: map[string]string{"id_role": "4"}, wantTotal: 1},
+			{name: "invalid role", filters: map[string]string{"id_role": "abc"}, wantErr: pagination.ErrInvalidFilter},
+			{name: "unknown filter", filters: map[string]string{"password": "x"}, wantErr: pagination.ErrInvalidFilter},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, page, err := repo.FindAll(ctx, listParams(tt.filters))
+				expectErr(t, err, tt.wantErr)
+				if tt.wantErr == nil && page.Total != tt.wantTotal {
+					t.Fatalf("expected total %d, got %d", tt.wantTotal, page.Total)
+				}
+			})
+		}
+	})
+
+	t.Run("cursor pagination", func(t *testing.T) {
+		p := pagination.Params{Limit: 2, Sort: "code"}
+
+		first, page, err := repo.FindAll(ctx, p)
+		expectErr(t, err, nil)
+		if len(first) != 2 || page.NextCursor == "" {
+			t.Fatalf("expected a full first page with a cursor, got %d rows, cursor %q", len(first), page.NextCursor)
+		}
+
+		p.Cursor, err = pagination.DecodeCursor(page.NextCursor)
+		expectErr(t, err, nil)
+
+		rest, page, err := repo.FindAll(ctx, p)
+		expectErr(t, err, nil)
+		if len(rest) != 1 || rest[0].EmployeeCode != "SA-001" || page.NextCursor != "" {
+			t.Fatalf("expected SA-001 on the last page, got %+v (cursor %q)", rest, page.NextCursor)
+		}
+
+		p.Sort = "name"
+		_, _, err = repo.FindAll(ctx, p)
+		expectErr(t, err, pagination.ErrInvalidCursor)
+	})
+}
+
+func TestMemoryProductRepository(t *testing.T) {
+	store := newMemoryStore(t)
+	products := memory.NewProductRepository(store)
+	details := memory.NewProductDetailRepository(store)
+	inventories := memory.NewInventoryRepository(store)
+	ctx := context.Background()
+
+	t.Run("Product Save", func(t *testing.T) {
+		expectErr(t, products.Save(ctx, &models.Product{ProductName: "Copy", ProductCode: "PRD-001", IDCategory: 1}), repository.ErrDuplicate)
+
+		err := products.Save(ctx, &models.Product{ProductName: "Ghost", ProductCode: "PRD-009", IDCategory: 99})
+		expectErr(t, err, repository.ErrCategoryNotFound)
+		// category dari body request adalah kesalahan validasi, bukan 404
+		if appErr, ok := apperror.From(err); !ok || appErr.Kind != apperror.KindValidation {
+			t.Fatalf("expected validation kind, got %v", err)
+		}
+	})
+
+	t.Run("ProductDetail Save", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			detail  models.ProductDetail
+			wantErr error
+		}{
+			{name: "duplicate barcode", detail: models.ProductDetail{CodeProduct: "PRD-002", IDSize: 1, Barcode: "4006381333931"}, wantErr: repository.ErrBarcodeExists},
+			{name: "duplicate size", detail: models.ProductDetail{CodeProduct: "PRD-001", IDSize: 1, Barcode: "4006381333979"}, wantErr: repository.ErrProductDetailExists},
+			{name: "unknown size", detail: models.ProductDetail{CodeProduct: "PRD-001", IDSize: 99, Barcode: "4006381333986"}, wantErr: repository.ErrSizeNotFound},
+			{name: "unknown product", detail: models.ProductDetail{CodeProduct: "PRD-999", IDSize: 1, Barcode: "4006381333993"}, wantErr: repository.ErrProductNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, details.Save(ctx, &tt.detail), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("Inventory Adjust", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			product   string
+			size      int
+			warehouse string
+			delta     int
+			wantQty   int
+			wantErr   error
+		}{
+			{name: "reduce", product: "PRD-001", size: 1, warehouse: "WH-01", delta: -3, wantQty: 7},
+			{name: "add to new warehouse", product: "PRD-001", size: 1, warehouse: "WH-03", delta: 4, wantQty: 4},
+			{name: "below zero", product: "PRD-001", size: 2, warehouse: "WH-01", delta: -6, wantErr: repository.ErrInsufficientStock},
+			{name: "reduce without stock row", product: "PRD-002", size: 3, warehouse: "WH-01", delta: -1, wantErr: repository.ErrInsufficientStock},
+			{name: "unknown warehouse", product: "PRD-001", size: 1, warehouse: "WH-99", delta: 1, wantErr: repository.ErrWarehouseNotFound},
+			{name: "unknown product", product: "PRD-999", size: 1, warehouse: "WH-01", delta: 1, wantErr: repository.ErrProductNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				inv, err := inventories.Adjust(ctx, tt.product, tt.size, tt.warehouse, tt.delta)
+				expectErr(t, err, tt.wantErr)
+				if tt.wantErr == nil && inv.Quantity != tt.wantQty {
+					t.Fatalf("expected quantity %d, got %d", tt.wantQty, inv.Quantity)
+				}
+			})
+		}
+
+		stock, err := inventories.FindAllByVariant(ctx, "PRD-001", 1)
+		expectErr(t, err, nil)
+		if len(stock) != 2 || stock[0].CodeWarehouse != "WH-01" || stock[1].Warehouse.WarehouseName != "WH-03" {
+			t.Fatalf("expected stock in WH-01 and WH-03, got %+v", stock)
+		}
+	})
+
+	t.Run("Delete cascades", func(t *testing.T) {
+		expectErr(t, products.Delete(ctx, 2), nil)
+
+		_, err := details.FindByBarcode(ctx, "4006381333955")
+		expectErr(t, err, repository.ErrProductDetailNotFound)
+
+		stock, err := inventories.FindAllByWarehouse(ctx, "WH-02")
+		expectErr(t, err, nil)
+		if len(stock) != 0 {
+			t.Fatalf("expected stock of PRD-002 to be deleted, got %+v", stock)
+		}
+	})
+}
+
+func TestMemoryTransactionRepository(t *testing.T) {
+	store := newMemoryStore(t)
+	repo := memory.NewTransactionRepository(store)
+	inventories := memory.NewInventoryRepository(store)
+	ctx := context.Background()
+
+	t.Run("Save", func(t *testing.T) {
+		expectErr(t, repo.Save(ctx, newTransaction("IN-1", models.TransactionInbound, models.StatusPending, line(1, 2), line(3, 1))), nil)
+		expectErr(t, repo.Save(ctx, newTransaction("IN-1", models.TransactionInbound, models.StatusPending)), repository.ErrDuplicate)
+		expectErr(t, repo.Save(ctx, newTransaction("IN-2", models.TransactionInbound, 99)), repository.ErrInvalidReference)
+		expectErr(t, repo.Save(ctx, newTransaction("IN-3", models.TransactionInbound, models.StatusPending, line(99, 1))), repository.ErrProductDetailNotFound)
+
+		// detail yang gagal disimpan membatalkan header
+		_, err := repo.FindByCode(ctx, "IN-3")
+		expectErr(t, err, repository.ErrTransactionNotFound)
+
+		trx, err := repo.FindByCode(ctx, "IN-1")
+		expectErr(t, err, nil)
+		if trx.Status.Name != "Pending" || len(trx.Details) != 2 || trx.Details[0].ProductDetail.Barcode != "4006381333931" {
+			t.Fatalf("unexpected transaction: %+v", trx)
+		}
+	})
+
+	t.Run("SaveWithReservation", func(t *testing.T) {
+		expectErr(t, repo.SaveWithReservation(ctx, newTransaction("OUT-1", models.TransactionOutbound, models.StatusPending, line(1, 8)), "WH-01"), nil)
+
+		err := repo.SaveWithReservation(ctx, newTransaction("OUT-2", models.TransactionOutbound, models.StatusPending, line(1, 5), line(2, 1)), "WH-01")
+		var shortage *repository.StockShortageError
+		if !errors.As(err, &shortage) || len(shortage.Lines) != 1 || shortage.Lines[0].Available != 2 {
+			t.Fatalf("expected one shortage line with 2 available, got %v", err)
+		}
+	})
+
+	t.Run("UpdateStatus rolls back on shortage", func(t *testing.T) {
+		out, err := repo.FindByCode(ctx, "OUT-1")
+		expectErr(t, err, nil)
+
+		// baris WH-01 PRD-001/M dijalankan lebih dulu dan harus ikut dibatalkan
+		movements := []repository.InventoryMovement{
+			{CodeProduct: "PRD-001", IDSize: 2, CodeWarehouse: "WH-01", Delta: -1},
+			{CodeProduct: "PRD-001", IDSize: 1, CodeWarehouse: "WH-01", Delta: -11},
+		}
+		expectErr(t, repo.UpdateStatus(ctx, out.ID, models.StatusPending, models.StatusCompleted, movements), repository.ErrInsufficientStock)
+
+		stock, err := inventories.FindAllByVariant(ctx, "PRD-001", 2)
+		expectErr(t, err, nil)
+		if stock[0].Quantity != 5 {
+			t.Fatalf("expected stock to stay at 5, got %d", stock[0].Quantity)
+		}
+
+		expectErr(t, repo.UpdateStatus(ctx, out.ID, models.StatusPending, models.StatusCompleted, movements[:1]), nil)
+		expectErr(t, repo.UpdateStatus(ctx, out.ID, models.StatusPending, models.StatusCompleted, movements[:1]), repository.ErrTransactionStatusChanged)
+	})
+
+	t.Run("Scan", func(t *testing.T) {
+		inbound, err := repo.FindByCode(ctx, "IN-1")
+		expectErr(t, err, nil)
+
+		id, err := repo.Scan(ctx, inbound.ID, "4006381333931", 2)
+		expectErr(t, err, nil)
+		if id != 1 {
+			t.Fatalf("expected variant 1, got %d", id)
+		}
+
+		_, err = repo.Scan(ctx, inbound.ID, "4006381333931", 1)
+		expectErr(t, err, repository.ErrScanExceedsQuantity)
+		_, err = repo.Scan(ctx, inbound.ID, "4006381333948", 1)
+		expectErr(t, err, repository.ErrTransactionItemNotFound)
+		_, err = repo.Scan(ctx, inbound.ID, "0000000000000", 1)
+		expectErr(t, err, repository.ErrProductDetailNotFound)
+	})
+
+	t.Run("Receive", func(t *testing.T) {
+		transfer := newTransaction("TRF-1", models.TransactionTransfer, models.StatusInTransit, line(2, 3))
+		expectErr(t, repo.Save(ctx, transfer), nil)
+
+		expectErr(t, repo.Receive(ctx, transfer.ID, "WH-02", []models.DetailTransaction{line(2, 2)}), nil)
+		expectErr(t, repo.Receive(ctx, transfer.ID, "WH-02", []models.DetailTransaction{line(2, 2)}), repository.ErrReceiveExceedsQuantity)
+		expectErr(t, repo.Receive(ctx, transfer.ID, "WH-02", []models.DetailTransaction{line(3, 1)}), repository.ErrTransactionItemNotFound)
+		expectErr(t, repo.Receive(ctx, transfer.ID, "WH-02", []models.DetailTransaction{line(2, 1)}), nil)
+
+		trx, err := repo.FindByCode(ctx, "TRF-1")
+		expectErr(t, err, nil)
+		if trx.IDStatus != models.StatusCompleted {
+			t.Fatalf("expected completed transfer, got status %d", trx.IDStatus)
+		}
+	})
+
+	t.Run("referenced rows cannot be deleted", func(t *testing.T) {
+		expectErr(t, memory.NewProductRepository(store).Delete(ctx, 1), repository.ErrStillReferenced)
+		expectErr(t, memory.NewEmployeeRepository(store).Delete(ctx, "EMP-001"), repository.ErrStillReferenced)
+	})
+}
+
+func TestMemoryStoreConcurrentAdjust(t *testing.T) {
+	inventories := memory.NewInventoryRepository(newMemoryStore(t))
+	ctx := context.Background()
+
+	// 10 stok dikurangi 20 kali secara bersamaan: tepat 10 yang berhasil
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := inventories.Adjust(ctx, "PRD-001", 1, "WH-01", -1); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if succeeded != 10 {
+		t.Fatalf("expected 10 successful adjustments, got %d", succeeded)
+	}
+}
@@ -0,0 +1,238 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	authmiddleware "github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/middelware"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository/memory"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/logging"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/middleware"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+var employeeClaims = &auth.Claims{EmployeeCode: "EMP-001", IDRole: roleEmployee, Role: "employee", WarehouseCode: "WH-02"}
+
+// newAuthRouter memasang claims tetap (menggantikan Authenticate) lalu middleware
+// yang diuji; handler /scope mengembalikan scope warehouse request
+func newAuthRouter(t *testing.T, claims *auth.Claims, handlers ...gin.HandlerFunc) *gin.Engine {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if claims != nil {
+			auth.SetClaims(c, claims)
+		}
+	})
+	r.Use(handlers...)
+	r.GET("/scope", func(c *gin.Context) {
+		c.JSON(http.StatusOK, response.ApiResponse{Status: http.StatusOK, Message: "success", Data: auth.ScopeFrom(c.Request.Context())})
+	})
+	return r
+}
+
+func TestAuthorize(t *testing.T) {
+	roles := service.NewRoleServices(memory.NewRoleRepository(newMemoryStore(t)))
+
+	tests := []struct {
+		name       string
+		claims     *auth.Claims
+		permission auth.Permission
+		wantStatus int
+		wantCode   string
+	}{
+		{"employee without permission", employeeClaims, auth.PermAuditRead, http.StatusForbidden, "permission_denied"},
+		{"admin with permission", adminClaims, auth.PermAuditRead, http.StatusOK, ""},
+		{"super admin has every permission", superAdminClaims, auth.PermRoleDelete, http.StatusOK, ""},
+		{"no login", nil, auth.PermAuditRead, http.StatusUnauthorized, "unauthorized"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newAuthRouter(t, tt.claims, authmiddleware.Authorize(roles, tt.permission))
+
+			status, resp := serve(t, r, http.MethodGet, "/scope", nil)
+			if status != tt.wantStatus || resp.Code != tt.wantCode {
+				t.Fatalf("expected %d %q, got %d %q (%s)", tt.wantStatus, tt.wantCode, status, resp.Code, resp.Message)
+			}
+		})
+	}
+}
+
+func TestResolveScope(t *testing.T) {
+	tests := []struct {
+		name          string
+		header        string
+		allWarehouses bool
+		want          auth.WarehouseScope
+		wantOK        bool
+	}{
+		{"own warehouse without header", "", false, auth.WarehouseScope{WarehouseCode: "WH-02"}, true},
+		{"own warehouse in header", "WH-02", false, auth.WarehouseScope{WarehouseCode: "WH-02"}, true},
+		{"other warehouse in header", "WH-01", false, auth.WarehouseScope{}, false},
+		{"all warehouses without header", "", true, auth.WarehouseScope{All: true}, true},
+		{"all warehouses narrowed by header", "WH-03", true, auth.WarehouseScope{WarehouseCode: "WH-03"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, ok := auth.ResolveScope(employeeClaims, tt.header, tt.allWarehouses)
+			if ok != tt.wantOK || scope != tt.want {
+				t.Fatalf("expected %+v %v, got %+v %v", tt.want, tt.wantOK, scope, ok)
+			}
+		})
+	}
+
+	t.Run("zero scope allows nothing", func(t *testing.T) {
+		if (auth.WarehouseScope{}).Allows("") || (auth.WarehouseScope{}).Allows("WH-01") {
+			t.Fatal("expected zero scope to allow no warehouse")
+		}
+	})
+}
+
+func TestScopeWarehouse(t *testing.T) {
+	roles := service.NewRoleServices(memory.NewRoleRepository(newMemoryStore(t)))
+
+	tests := []struct {
+		name       string
+		claims     *auth.Claims
+		header     string
+		wantStatus int
+		wantScope  map[string]any
+	}{
+		{"employee without header", employeeClaims, "", http.StatusOK, map[string]any{"All": false, "WarehouseCode": "WH-02"}},
+		{"employee with other warehouse", employeeClaims, "WH-01", http.StatusForbidden, nil},
+		{"admin without header", adminClaims, "", http.StatusOK, map[string]any{"All": true, "WarehouseCode": ""}},
+		{"admin with header", adminClaims, "WH-03", http.StatusOK, map[string]any{"All": false, "WarehouseCode": "WH-03"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newAuthRouter(t, tt.claims, authmiddleware.ScopeWarehouse(roles))
+
+			req := httptest.NewRequest(http.MethodGet, "/scope", nil)
+			if tt.header != "" {
+				req.Header.Set(auth.WarehouseHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			var resp response.ApiResponse
+			expectErr(t, json.Unmarshal(rec.Body.Bytes(), &resp), nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d %q", tt.wantStatus, rec.Code, resp.Code)
+			}
+			if tt.wantScope == nil {
+				if resp.Code != "warehouse_forbidden" {
+					t.Fatalf("expected warehouse_forbidden, got %q", resp.Code)
+				}
+				return
+			}
+			scope, _ := resp.Data.(map[string]any)
+			if scope["All"] != tt.wantScope["All"] || scope["WarehouseCode"] != tt.wantScope["WarehouseCode"] {
+				t.Fatalf("expected scope %v, got %v", tt.wantScope, scope)
+			}
+		})
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+
+	r := gin.New()
+	r.Use(middleware.RequestID(), middleware.Recovery(logger))
+	r.GET("/panic", func(c *gin.Context) {
+		panic("koneksi gagal: password=rahasia-db")
+	})
+	r.GET("/ok", func(c *gin.Context) {
+		c.JSON(http.StatusOK, response.ApiResponse{Status: http.StatusOK, Message: "success"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/panic", nil)
+	req.Header.Set(middleware.RequestIDHeader, "req-123")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected 500, got %d", rec.Code)
+	}
+	if strings.Contains(rec.Body.String(), "rahasia-db") {
+		t.Fatalf("panic value leaked to client: %s", rec.Body.String())
+	}
+	var resp response.ApiResponse
+	expectErr(t, json.Unmarshal(rec.Body.Bytes(), &resp), nil)
+	if resp.Code != "internal_error" || rec.Header().Get(middleware.CorrelationHeader) != "req-123" {
+		t.Fatalf("expected internal_error with correlation id req-123, got %q %q", resp.Code, rec.Header().Get(middleware.CorrelationHeader))
+	}
+
+	// nilai panic hanya tercatat di log, bersama request ID-nya
+	if !strings.Contains(logs.String(), "rahasia-db") || !strings.Contains(logs.String(), `"correlation_id":"req-123"`) {
+		t.Fatalf("expected panic and correlation id in log, got %s", logs.String())
+	}
+
+	t.Run("request without panic is untouched", func(t *testing.T) {
+		status, resp := serve(t, r, http.MethodGet, "/ok", nil)
+		if status != http.StatusOK || resp.Message != "success" {
+			t.Fatalf("expected 200 success, got %d %q", status, resp.Message)
+		}
+	})
+}
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.RequestID())
+	r.GET("/id", func(c *gin.Context) {
+		c.String(http.StatusOK, logging.RequestID(c.Request.Context()))
+	})
+
+	request := func(header string) (echoed, inContext string) {
+		req := httptest.NewRequest(http.MethodGet, "/id", nil)
+		if header != "" {
+			req.Header.Set(middleware.RequestIDHeader, header)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec.Header().Get(middleware.RequestIDHeader), rec.Body.String()
+	}
+
+	t.Run("client id is echoed", func(t *testing.T) {
+		echoed, inContext := request("req-abc_1.2:3")
+		if echoed != "req-abc_1.2:3" || inContext != echoed {
+			t.Fatalf("expected client id echoed and stored, got %q %q", echoed, inContext)
+		}
+	})
+
+	for name, header := range map[string]string{
+		"missing":   "",
+		"invalid":   "id dengan spasi\n",
+		"too long":  strings.Repeat("a", 129),
+		"non ascii": "permintaan-é",
+	} {
+		t.Run(name+" id is generated", func(t *testing.T) {
+			echoed, inContext := request(header)
+			if echoed == "" || echoed == header || inContext != echoed {
+				t.Fatalf("expected a generated id, got %q %q", echoed, inContext)
+			}
+		})
+	}
+
+	t.Run("generated ids differ", func(t *testing.T) {
+		first, _ := request("")
+		second, _ := request("")
+		if first == second {
+			t.Fatalf("expected unique ids, got %q twice", first)
+		}
+	})
+}
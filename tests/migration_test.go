@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/migrate"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/migrations"
+)
+
+// appliedSince menghitung migration yang sudah diterapkan mulai dari version, yaitu
+// jumlah langkah Down untuk membatalkan version tersebut
+func appliedSince(t *testing.T, ctx context.Context, migrator *migrate.Migrator, version uint64) int {
+	t.Helper()
+
+	statuses, err := migrator.Status(ctx)
+	expectErr(t, err, nil)
+
+	n := 0
+	for _, s := range statuses {
+		if s.Applied && s.Version >= version {
+			n++
+		}
+	}
+	return n
+}
+
+func TestMigrationDownTransferSupport(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	migrator, err := migrate.New(db, migrations.FS)
+	expectErr(t, err, nil)
+
+	transactions := repository.NewTransactionRepository(db)
+	expectErr(t, transactions.Save(ctx, newTransaction("TR-1", models.TransactionTransfer, models.StatusInTransit, line(1, 2))), nil)
+
+	t.Run("in transit transfer blocks rollback", func(t *testing.T) {
+		_, err := migrator.Down(ctx, appliedSince(t, ctx, migrator, 5))
+		if err == nil || !strings.Contains(err.Error(), "still In Transit") {
+			t.Fatalf("expected rollback to be refused while a transfer is in transit, got %v", err)
+		}
+
+		// migration yang gagal tidak meninggalkan skema setengah jadi
+		var inTransit int
+		err = db.QueryRowContext(ctx, `SELECT count(*) FROM "status" WHERE "name" = 'In Transit'`).Scan(&inTransit)
+		expectErr(t, err, nil)
+		if _, err := db.ExecContext(ctx, `SELECT "received_quantity" FROM "detail_transactions" LIMIT 1`); err != nil || inTransit != 1 {
+			t.Fatalf("expected 000005 to stay applied, got status rows %d, error %v", inTransit, err)
+		}
+	})
+
+	t.Run("rollback succeeds once transfer is received", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, `UPDATE "transactions" SET "id_status" = $1 WHERE "code_transaksi" = 'TR-1'`, models.StatusCompleted)
+		expectErr(t, err, nil)
+
+		// migration setelah 000005 sudah dibatalkan pada percobaan sebelumnya
+		_, err = migrator.Down(ctx, appliedSince(t, ctx, migrator, 5))
+		expectErr(t, err, nil)
+
+		var inTransit int
+		err = db.QueryRowContext(ctx, `SELECT count(*) FROM "status" WHERE "name" = 'In Transit'`).Scan(&inTransit)
+		expectErr(t, err, nil)
+		if inTransit != 0 {
+			t.Fatal("expected In Transit status to be removed")
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"net/url"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/pagination"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository/memory"
+)
+
+func TestPaginationParse(t *testing.T) {
+	t.Run("valid query", func(t *testing.T) {
+		p, err := pagination.Parse(url.Values{"limit": {"5"}, "page": {"2"}, "sort": {"-name"}, "name": {"Jakarta"}, "code": {""}})
+		expectErr(t, err, nil)
+		if p.Limit != 5 || p.Page != 2 || p.Sort != "name" || !p.Desc || p.Offset() != 5 {
+			t.Fatalf("unexpected params %+v", p)
+		}
+		// filter kosong diabaikan
+		if len(p.Filters) != 1 || p.Filters["name"] != "Jakarta" {
+			t.Fatalf("expected only the name filter, got %v", p.Filters)
+		}
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		p, err := pagination.Parse(url.Values{})
+		expectErr(t, err, nil)
+		if p.Limit != pagination.DefaultLimit || p.Page != 0 || p.Cursor != nil || p.Sort != "" {
+			t.Fatalf("unexpected default params %+v", p)
+		}
+	})
+
+	cursor := pagination.EncodeCursor(pagination.Cursor{Sort: "code", Value: "WH-01", Key: "1"})
+	tests := []struct {
+		name    string
+		query   url.Values
+		wantErr error
+	}{
+		{"limit not a number", url.Values{"limit": {"sepuluh"}}, pagination.ErrInvalidLimit},
+		{"limit zero", url.Values{"limit": {"0"}}, pagination.ErrInvalidLimit},
+		{"limit above max", url.Values{"limit": {"101"}}, pagination.ErrInvalidLimit},
+		{"page zero", url.Values{"page": {"0"}}, pagination.ErrInvalidPage},
+		{"page and cursor", url.Values{"page": {"2"}, "cursor": {cursor}}, pagination.ErrPageAndCursor},
+		{"cursor not base64", url.Values{"cursor": {"bukan cursor!"}}, pagination.ErrInvalidCursor},
+		{"cursor not json", url.Values{"cursor": {base64.RawURLEncoding.EncodeToString([]byte("WH-01"))}}, pagination.ErrInvalidCursor},
+		{"cursor without key", url.Values{"cursor": {pagination.EncodeCursor(pagination.Cursor{Sort: "code", Value: "WH-01"})}}, pagination.ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pagination.Parse(tt.query)
+			expectErr(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPaginationSortIsCheckedByRepository(t *testing.T) {
+	// Parse tidak mengenal field sort setiap endpoint; field sort dan cursor dicek
+	// oleh FindAll repository
+	warehouses := memory.NewWarehouseRepository(newMemoryStore(t))
+	cursor := pagination.EncodeCursor(pagination.Cursor{Sort: "code", Value: "WH-01", Key: "1"})
+
+	tests := []struct {
+		name    string
+		query   url.Values
+		wantErr error
+	}{
+		{"known sort", url.Values{"sort": {"-code"}}, nil},
+		{"unknown sort", url.Values{"sort": {"location"}}, pagination.ErrInvalidSort},
+		{"cursor from another sort", url.Values{"sort": {"name"}, "cursor": {cursor}}, pagination.ErrInvalidCursor},
+		{"cursor from another direction", url.Values{"sort": {"-code"}, "cursor": {cursor}}, pagination.ErrInvalidCursor},
+		{"cursor with matching sort", url.Values{"sort": {"code"}, "cursor": {cursor}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := pagination.Parse(tt.query)
+			expectErr(t, err, nil)
+
+			_, _, err = warehouses.FindAll(context.Background(), p)
+			expectErr(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+func TestProductRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := repository.NewProductRepository(db)
+	ctx := context.Background()
+
+	t.Run("FindByCode", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			code         string
+			wantCategory string
+			wantErr      error
+		}{
+			{name: "with description", code: "PRD-001", wantCategory: "shirt"},
+			{name: "null description", code: "PRD-002", wantCategory: "pants"},
+			{name: "not found", code: "PRD-999", wantErr: repository.ErrProductNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				product, err := repo.FindByCode(ctx, tt.code)
+				expectErr(t, err, tt.wantErr)
+				if tt.wantErr == nil && product.Category.Name != tt.wantCategory {
+					t.Fatalf("expected category %q, got %q", tt.wantCategory, product.Category.Name)
+				}
+			})
+		}
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			product models.Product
+			wantErr error
+		}{
+			{name: "new", product: models.Product{ProductName: "Sneaker", Price: 499000, ProductCode: "PRD-003", IDCategory: 3}},
+			{name: "duplicate code", product: models.Product{ProductName: "Copy", Price: 1, ProductCode: "PRD-001", IDCategory: 1}, wantErr: repository.ErrDuplicate},
+			{name: "unknown category", product: models.Product{ProductName: "Ghost", Price: 1, ProductCode: "PRD-004", IDCategory: 99}, wantErr: repository.ErrCategoryNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Save(ctx, &tt.product), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			product models.Product
+			wantErr error
+		}{
+			{name: "price", product: models.Product{ID: 1, ProductName: "Basic Tee", Price: 89000, IDCategory: 1}},
+			{name: "unknown category", product: models.Product{ID: 1, ProductName: "Basic Tee", Price: 89000, IDCategory: 99}, wantErr: repository.ErrCategoryNotFound},
+			{name: "not found", product: models.Product{ID: 99, ProductName: "Ghost", IDCategory: 1}, wantErr: repository.ErrProductNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Update(ctx, &tt.product), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("FindAll", func(t *testing.T) {
+		products, page, err := repo.FindAll(ctx, listParams(map[string]string{"category": "shirt"}))
+		expectErr(t, err, nil)
+		if page.Total != 1 || len(products) != 1 || products[0].Price != 89000 {
+			t.Fatalf("expected updated Basic Tee only, got total %d: %+v", page.Total, products)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		// variant dan stok PRD-002 ikut terhapus (ON DELETE CASCADE)
+		expectErr(t, repo.Delete(ctx, 2), nil)
+		expectErr(t, repo.Delete(ctx, 99), repository.ErrProductNotFound)
+	})
+}
+
+func TestProductDetailRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := repository.NewProductDetailRepository(db)
+	ctx := context.Background()
+
+	t.Run("FindByBarcode", func(t *testing.T) {
+		detail, err := repo.FindByBarcode(ctx, "4006381333948")
+		expectErr(t, err, nil)
+		if detail.ID != 2 || detail.Size.Name != "M" || detail.Product.ProductName != "Basic Tee" {
+			t.Fatalf("unexpected variant: %+v", detail)
+		}
+
+		_, err = repo.FindByBarcode(ctx, "0000000000000")
+		expectErr(t, err, repository.ErrProductDetailNotFound)
+	})
+
+	t.Run("FindAllByProduct", func(t *testing.T) {
+		details, err := repo.FindAllByProduct(ctx, "PRD-001")
+		expectErr(t, err, nil)
+		if len(details) != 2 || details[0].IDSize != 1 || details[1].IDSize != 2 {
+			t.Fatalf("expected sizes S and M ordered by size, got %+v", details)
+		}
+	})
+
+	t.Run("ExistsByProductAndSize", func(t *testing.T) {
+		tests := []struct {
+			code string
+			size int
+			want bool
+		}{
+			{code: "PRD-001", size: 1, want: true},
+			{code: "PRD-001", size: 3, want: false},
+		}
+
+		for _, tt := range tests {
+			exists, err := repo.ExistsByProductAndSize(ctx, tt.code, tt.size)
+			expectErr(t, err, nil)
+			if exists != tt.want {
+				t.Fatalf("ExistsByProductAndSize(%q, %d): expected %v, got %v", tt.code, tt.size, tt.want, exists)
+			}
+		}
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			detail  models.ProductDetail
+			wantErr error
+		}{
+			{name: "new", detail: models.ProductDetail{CodeProduct: "PRD-001", IDSize: 3, Barcode: "4006381333962"}},
+			{name: "duplicate barcode", detail: models.ProductDetail{CodeProduct: "PRD-002", IDSize: 1, Barcode: "4006381333931"}, wantErr: repository.ErrBarcodeExists},
+			{name: "duplicate size", detail: models.ProductDetail{CodeProduct: "PRD-001", IDSize: 1, Barcode: "4006381333979"}, wantErr: repository.ErrProductDetailExists},
+			{name: "unknown size", detail: models.ProductDetail{CodeProduct: "PRD-001", IDSize: 99, Barcode: "4006381333986"}, wantErr: repository.ErrSizeNotFound},
+			{name: "unknown product", detail: models.ProductDetail{CodeProduct: "PRD-999", IDSize: 1, Barcode: "4006381333993"}, wantErr: repository.ErrProductNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Save(ctx, &tt.detail), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		expectErr(t, repo.Delete(ctx, 3), nil)
+		expectErr(t, repo.Delete(ctx, 99), repository.ErrProductDetailNotFound)
+	})
+}
+
+func TestInventoryRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := repository.NewInventoryRepository(db)
+	ctx := context.Background()
+
+	t.Run("Adjust", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			product   string
+			size      int
+			warehouse string
+			delta     int
+			wantQty   int
+			wantErr   error
+		}{
+			{name: "reduce", product: "PRD-001", size: 1, warehouse: "WH-01", delta: -3, wantQty: 7},
+			{name: "add to new warehouse", product: "PRD-001", size: 1, warehouse: "WH-03", delta: 4, wantQty: 4},
+			{name: "below zero", product: "PRD-001", size: 2, warehouse: "WH-01", delta: -6, wantErr: repository.ErrInsufficientStock},
+			{name: "reduce without stock row", product: "PRD-002", size: 3, warehouse: "WH-01", delta: -1, wantErr: repository.ErrInsufficientStock},
+			{name: "unknown warehouse", product: "PRD-001", size: 1, warehouse: "WH-99", delta: 1, wantErr: repository.ErrWarehouseNotFound},
+			{name: "unknown size", product: "PRD-001", size: 99, warehouse: "WH-01", delta: 1, wantErr: repository.ErrSizeNotFound},
+			{name: "unknown product", product: "PRD-999", size: 1, warehouse: "WH-01", delta: 1, wantErr: repository.ErrProductNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				inv, err := repo.Adjust(ctx, tt.product, tt.size, tt.warehouse, tt.delta)
+				expectErr(t, err, tt.wantErr)
+				if tt.wantErr == nil && inv.Quantity != tt.wantQty {
+					t.Fatalf("expected quantity %d, got %d", tt.wantQty, inv.Quantity)
+				}
+			})
+		}
+	})
+
+	t.Run("FindAllByVariant", func(t *testing.T) {
+		inventories, err := repo.FindAllByVariant(ctx, "PRD-001", 1)
+		expectErr(t, err, nil)
+		if len(inventories) != 2 || inventories[0].CodeWarehouse != "WH-01" || inventories[1].CodeWarehouse != "WH-03" {
+			t.Fatalf("expected stock in WH-01 and WH-03, got %+v", inventories)
+		}
+	})
+
+	t.Run("FindAllByWarehouse", func(t *testing.T) {
+		inventories, err := repo.FindAllByWarehouse(ctx, "WH-02")
+		expectErr(t, err, nil)
+		if len(inventories) != 1 || inventories[0].Quantity != 7 || inventories[0].Warehouse.WarehouseName != "WH-02" {
+			t.Fatalf("unexpected stock in WH-02: %+v", inventories)
+		}
+	})
+}
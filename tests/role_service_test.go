@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository/memory"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+)
+
+func TestRolePermissionGrant(t *testing.T) {
+	// admin diberi role:write supaya bisa membuat role, tapi tetap tidak memiliki role:delete
+	newRoles := func(t *testing.T) service.RoleServices {
+		t.Helper()
+
+		roles := service.NewRoleServices(memory.NewRoleRepository(newMemoryStore(t)))
+		admin, err := roles.GetRoleById(context.Background(), roleAdmin)
+		expectErr(t, err, nil)
+		_, err = roles.SetPermissions(loggedIn(superAdminClaims), roleAdmin, &request.SetRolePermissions{
+			Permissions: append(admin.Permissions, string(auth.PermRoleWrite)),
+		})
+		expectErr(t, err, nil)
+		return roles
+	}
+
+	tests := []struct {
+		name        string
+		claims      *auth.Claims
+		permissions []string
+		wantErr     error
+	}{
+		{"admin grants permissions it holds", adminClaims, []string{"transaction:read", "role:write"}, nil},
+		{"admin cannot grant role:delete", adminClaims, []string{"transaction:read", "role:delete"}, service.ErrPermissionGrantForbidden},
+		{"super admin grants anything", superAdminClaims, []string{"role:delete"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run("create/"+tt.name, func(t *testing.T) {
+			_, err := newRoles(t).CreateRole(loggedIn(tt.claims), &request.CreateRole{RoleName: "auditor", Permissions: tt.permissions})
+			expectErr(t, err, tt.wantErr)
+		})
+
+		t.Run("set/"+tt.name, func(t *testing.T) {
+			roles := newRoles(t)
+			before, err := roles.GetRoleById(context.Background(), roleEmployee)
+			expectErr(t, err, nil)
+
+			_, err = roles.SetPermissions(loggedIn(tt.claims), roleEmployee, &request.SetRolePermissions{Permissions: tt.permissions})
+			expectErr(t, err, tt.wantErr)
+
+			// permission role tidak berubah jika ditolak
+			want := slices.Sorted(slices.Values(tt.permissions))
+			if tt.wantErr != nil {
+				want = before.Permissions
+			}
+			after, err := roles.GetRoleById(context.Background(), roleEmployee)
+			expectErr(t, err, nil)
+			if !slices.Equal(after.Permissions, want) {
+				t.Fatalf("expected permissions %v, got %v", want, after.Permissions)
+			}
+		})
+	}
+
+	t.Run("no login", func(t *testing.T) {
+		_, err := newRoles(t).CreateRole(context.Background(), &request.CreateRole{RoleName: "auditor", Permissions: []string{"transaction:read"}})
+		expectErr(t, err, service.ErrUnauthenticated)
+	})
+}
@@ -0,0 +1,23 @@
+-- Fixture integration test, dijalankan setelah semua migration pada database template.
+-- Data awal migration 000003 (category, role, size, status, warehouse WH-01..WH-03 dan
+-- employee SA-001) tetap ada; fixture ini hanya menambah data yang dibutuhkan test.
+
+INSERT INTO "employee" ("user_id", "employee_name", "password", "employee_code", "id_role", "warehouse_code") VALUES
+('staff-uid', 'staff', 'not-a-real-hash', 'EMP-001', (SELECT "id" FROM "role" WHERE "role_name" = 'employee'), 'WH-02');
+
+INSERT INTO "product" ("product_name", "price", "description_product", "product_code", "id_category") VALUES
+('Basic Tee', 99000, 'Cotton t-shirt', 'PRD-001', 1),
+('Chino', 249000, NULL, 'PRD-002', 2);
+
+-- id ditulis eksplisit karena test mereferensikan variant berdasarkan id
+INSERT INTO "product_detail" ("id", "code_product", "id_size", "barcode") VALUES
+(1, 'PRD-001', 1, '4006381333931'),
+(2, 'PRD-001', 2, '4006381333948'),
+(3, 'PRD-002', 3, '4006381333955');
+
+SELECT setval(pg_get_serial_sequence('product_detail', 'id'), 3);
+
+INSERT INTO "inventory" ("code_product", "id_size", "code_warehouse", "quantity") VALUES
+('PRD-001', 1, 'WH-01', 10),
+('PRD-001', 2, 'WH-01', 5),
+('PRD-002', 3, 'WH-02', 7);
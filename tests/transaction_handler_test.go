@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/handler"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository/memory"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// newTransactionRouter memasang route transaksi dengan claims tetap, menggantikan
+// middleware Authenticate; claims nil berarti request tanpa login
+func newTransactionRouter(t *testing.T, store *memory.Store, claims *auth.Claims) *gin.Engine {
+	t.Helper()
+
+	r := newTestRouter(t, store)
+	transactions := handler.NewTransactionHandler(service.NewTransactionServices(
+		memory.NewTransactionRepository(store), memory.NewWarehouseRepository(store),
+		service.NewAuditServices(memory.NewAuditRepository(store)), memory.NewTxManager(store)))
+
+	group := r.Group("", func(c *gin.Context) {
+		if claims != nil {
+			auth.SetClaims(c, claims)
+			c.Request = c.Request.WithContext(auth.WithScope(c.Request.Context(), auth.WarehouseScope{WarehouseCode: claims.WarehouseCode}))
+		}
+	})
+	group.POST("/transactions/inbound", transactions.HandlerCreateInbound)
+	group.POST("/transactions/:code/scan", transactions.HandlerScanItem)
+	group.PATCH("/transactions/:code/status", transactions.HandlerChangeStatus)
+
+	return r
+}
+
+func TestCreateTransactionRecordsLoggedInEmployee(t *testing.T) {
+	// employee_code di body dicoba dipalsukan menjadi super admin
+	body := map[string]any{
+		"origin_entity_name":         "PT Supplier",
+		"destination_warehouse_code": "WH-02",
+		"employee_code":              "SA-001",
+		"items":                      []map[string]any{{"id_detail_product": 1, "quantity": 2}},
+	}
+
+	t.Run("employee comes from token", func(t *testing.T) {
+		r := newTransactionRouter(t, newMemoryStore(t), &auth.Claims{EmployeeCode: "EMP-001", WarehouseCode: "WH-02"})
+
+		status, resp := serve(t, r, http.MethodPost, "/transactions/inbound", body)
+		if status != http.StatusCreated {
+			t.Fatalf("expected 201, got %d %q (%s)", status, resp.Code, resp.Message)
+		}
+		data, _ := resp.Data.(map[string]any)
+		if data["employee_code"] != "EMP-001" {
+			t.Fatalf("expected transaction recorded under EMP-001, got %v", data["employee_code"])
+		}
+	})
+
+	t.Run("no login", func(t *testing.T) {
+		r := newTransactionRouter(t, newMemoryStore(t), nil)
+
+		status, resp := serve(t, r, http.MethodPost, "/transactions/inbound", body)
+		if status != http.StatusUnauthorized || resp.Code != "unauthenticated" {
+			t.Fatalf("expected 401 unauthenticated, got %d %q", status, resp.Code)
+		}
+	})
+}
+
+func TestTransactionHandlerStatusConflicts(t *testing.T) {
+	r := newTransactionRouter(t, newMemoryStore(t), &auth.Claims{EmployeeCode: "EMP-001", WarehouseCode: "WH-01"})
+
+	status, resp := serve(t, r, http.MethodPost, "/transactions/inbound", map[string]any{
+		"origin_entity_name":         "PT Supplier",
+		"destination_warehouse_code": "WH-01",
+		"items":                      []map[string]any{{"id_detail_product": 1, "quantity": 2}},
+	})
+	if status != http.StatusCreated {
+		t.Fatalf("expected 201, got %d %q", status, resp.Code)
+	}
+	data, _ := resp.Data.(map[string]any)
+	code, _ := data["code_transaksi"].(string)
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       any
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "unknown barcode", method: http.MethodPost, target: "/transactions/" + code + "/scan", body: map[string]any{"barcode": "5901234123457"}, wantStatus: http.StatusNotFound, wantCode: "product_variant_not_found"},
+		{name: "over-scan", method: http.MethodPost, target: "/transactions/" + code + "/scan", body: map[string]any{"barcode": "4006381333931", "quantity": 3}, wantStatus: http.StatusConflict, wantCode: "scan_exceeds_quantity"},
+		{name: "invalid barcode check digit", method: http.MethodPost, target: "/transactions/" + code + "/scan", body: map[string]any{"barcode": "4006381333932"}, wantStatus: http.StatusUnprocessableEntity, wantCode: "validation_failed"},
+		{name: "pending to in transit", method: http.MethodPatch, target: "/transactions/" + code + "/status", body: map[string]any{"id_status": 4}, wantStatus: http.StatusConflict, wantCode: "transfer_sent_by_dispatch"},
+		{name: "complete", method: http.MethodPatch, target: "/transactions/" + code + "/status", body: map[string]any{"id_status": 3}, wantStatus: http.StatusOK},
+		{name: "completed to failed", method: http.MethodPatch, target: "/transactions/" + code + "/status", body: map[string]any{"id_status": 1}, wantStatus: http.StatusConflict, wantCode: "illegal_status_transition"},
+		{name: "scan completed document", method: http.MethodPost, target: "/transactions/" + code + "/scan", body: map[string]any{"barcode": "4006381333931"}, wantStatus: http.StatusConflict, wantCode: "transaction_closed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, resp := serve(t, r, tt.method, tt.target, tt.body)
+			if status != tt.wantStatus || resp.Code != tt.wantCode {
+				t.Fatalf("expected %d %q, got %d %q (%s)", tt.wantStatus, tt.wantCode, status, resp.Code, resp.Message)
+			}
+		})
+	}
+}
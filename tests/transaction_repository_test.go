@@ -0,0 +1,209 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+)
+
+func newTransaction(code, tipe string, status uint, details ...models.DetailTransaction) *models.Transaction {
+	return &models.Transaction{
+		CodeTransaksi:         code,
+		OriginEntityName:      "WH-01",
+		DestinationEntityName: "WH-02",
+		EmployeeCode:          "EMP-001",
+		IDStatus:              status,
+		TipeTransaksi:         tipe,
+		Details:               details,
+	}
+}
+
+func line(idDetailProduct uint, quantity int) models.DetailTransaction {
+	return models.DetailTransaction{IDDetailProduct: idDetailProduct, Quantity: quantity}
+}
+
+func TestTransactionRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := repository.NewTransactionRepository(db)
+	inventories := repository.NewInventoryRepository(db)
+	ctx := context.Background()
+
+	t.Run("NextCodeSequence", func(t *testing.T) {
+		first, err := repo.NextCodeSequence(ctx)
+		expectErr(t, err, nil)
+		second, err := repo.NextCodeSequence(ctx)
+		expectErr(t, err, nil)
+		if second <= first {
+			t.Fatalf("expected increasing sequence, got %d then %d", first, second)
+		}
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			trx     *models.Transaction
+			wantErr error
+		}{
+			{name: "inbound", trx: newTransaction("IN-1", models.TransactionInbound, models.StatusPending, line(1, 2), line(3, 1))},
+			{name: "duplicate code", trx: newTransaction("IN-1", models.TransactionInbound, models.StatusPending), wantErr: repository.ErrDuplicate},
+			{name: "unknown employee", trx: func() *models.Transaction {
+				trx := newTransaction("IN-2", models.TransactionInbound, models.StatusPending)
+				trx.EmployeeCode = "EMP-999"
+				return trx
+			}(), wantErr: repository.ErrEmployeeNotFound},
+			{name: "unknown variant", trx: newTransaction("IN-3", models.TransactionInbound, models.StatusPending, line(99, 1)), wantErr: repository.ErrProductDetailNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Save(ctx, tt.trx), tt.wantErr)
+			})
+		}
+
+		// baris yang gagal disimpan tidak boleh meninggalkan header
+		_, err := repo.FindByCode(ctx, "IN-3")
+		expectErr(t, err, repository.ErrTransactionNotFound)
+	})
+
+	t.Run("FindByCode", func(t *testing.T) {
+		trx, err := repo.FindByCode(ctx, "IN-1")
+		expectErr(t, err, nil)
+		if trx.Status.Name != "Pending" || len(trx.Details) != 2 || trx.Details[0].ProductDetail.Barcode != "4006381333931" {
+			t.Fatalf("unexpected transaction: %+v", trx)
+		}
+
+		_, err = repo.FindByCode(ctx, "IN-999")
+		expectErr(t, err, repository.ErrTransactionNotFound)
+	})
+
+	t.Run("SaveWithReservation", func(t *testing.T) {
+		// stok PRD-001/S di WH-01 adalah 10
+		expectErr(t, repo.SaveWithReservation(ctx, newTransaction("OUT-1", models.TransactionOutbound, models.StatusPending, line(1, 8)), "WH-01"), nil)
+
+		// 8 sudah dipesan OUT-1 sehingga hanya tersisa 2
+		err := repo.SaveWithReservation(ctx, newTransaction("OUT-2", models.TransactionOutbound, models.StatusPending, line(1, 5), line(2, 1)), "WH-01")
+		expectErr(t, err, repository.ErrInsufficientStock)
+
+		var shortage *repository.StockShortageError
+		if !errors.As(err, &shortage) || len(shortage.Lines) != 1 {
+			t.Fatalf("expected one shortage line, got %v", err)
+		}
+		if got := shortage.Lines[0]; got.IDDetailProduct != 1 || got.Available != 2 || got.Shortfall != 3 {
+			t.Fatalf("unexpected shortage line: %+v", got)
+		}
+
+		err = repo.SaveWithReservation(ctx, newTransaction("OUT-3", models.TransactionOutbound, models.StatusPending, line(99, 1)), "WH-01")
+		expectErr(t, err, repository.ErrProductDetailNotFound)
+	})
+
+	t.Run("UpdateStatus", func(t *testing.T) {
+		out, err := repo.FindByCode(ctx, "OUT-1")
+		expectErr(t, err, nil)
+
+		movements := []repository.InventoryMovement{{CodeProduct: "PRD-001", IDSize: 1, CodeWarehouse: "WH-01", Delta: -8}}
+
+		tests := []struct {
+			name    string
+			id      uint
+			from    uint
+			wantErr error
+		}{
+			{name: "complete", id: out.ID, from: models.StatusPending},
+			{name: "completed twice", id: out.ID, from: models.StatusPending, wantErr: repository.ErrTransactionStatusChanged},
+			{name: "not found", id: 999, from: models.StatusPending, wantErr: repository.ErrTransactionNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.UpdateStatus(ctx, tt.id, tt.from, models.StatusCompleted, movements), tt.wantErr)
+			})
+		}
+
+		stock, err := inventories.FindAllByVariant(ctx, "PRD-001", 1)
+		expectErr(t, err, nil)
+		if len(stock) != 1 || stock[0].Quantity != 2 {
+			t.Fatalf("expected stock to be reduced once to 2, got %+v", stock)
+		}
+
+		// stok tidak cukup: status tidak berubah
+		inbound, err := repo.FindByCode(ctx, "IN-1")
+		expectErr(t, err, nil)
+		shortage := []repository.InventoryMovement{{CodeProduct: "PRD-001", IDSize: 1, CodeWarehouse: "WH-01", Delta: -3}}
+		expectErr(t, repo.UpdateStatus(ctx, inbound.ID, models.StatusPending, models.StatusCompleted, shortage), repository.ErrInsufficientStock)
+	})
+
+	t.Run("Scan", func(t *testing.T) {
+		inbound, err := repo.FindByCode(ctx, "IN-1")
+		expectErr(t, err, nil)
+
+		tests := []struct {
+			name     string
+			barcode  string
+			quantity int
+			wantID   uint
+			wantErr  error
+		}{
+			{name: "scan line", barcode: "4006381333931", quantity: 2, wantID: 1},
+			{name: "exceeds line quantity", barcode: "4006381333931", quantity: 1, wantErr: repository.ErrScanExceedsQuantity},
+			{name: "variant not on document", barcode: "4006381333948", quantity: 1, wantErr: repository.ErrTransactionItemNotFound},
+			{name: "unknown barcode", barcode: "0000000000000", quantity: 1, wantErr: repository.ErrProductDetailNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				id, err := repo.Scan(ctx, inbound.ID, tt.barcode, tt.quantity)
+				expectErr(t, err, tt.wantErr)
+				if tt.wantErr == nil && id != tt.wantID {
+					t.Fatalf("expected variant %d, got %d", tt.wantID, id)
+				}
+			})
+		}
+	})
+
+	t.Run("Receive", func(t *testing.T) {
+		transfer := newTransaction("TRF-1", models.TransactionTransfer, models.StatusInTransit, line(2, 3))
+		expectErr(t, repo.Save(ctx, transfer), nil)
+
+		tests := []struct {
+			name       string
+			items      []models.DetailTransaction
+			wantErr    error
+			wantStatus uint
+		}{
+			{name: "partial", items: []models.DetailTransaction{line(2, 2)}, wantStatus: models.StatusInTransit},
+			{name: "exceeds remaining", items: []models.DetailTransaction{line(2, 2)}, wantErr: repository.ErrReceiveExceedsQuantity},
+			{name: "variant not on document", items: []models.DetailTransaction{line(3, 1)}, wantErr: repository.ErrTransactionItemNotFound},
+			{name: "rest completes document", items: []models.DetailTransaction{line(2, 1)}, wantStatus: models.StatusCompleted},
+			{name: "already completed", items: []models.DetailTransaction{line(2, 1)}, wantErr: repository.ErrTransactionStatusChanged},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectErr(t, repo.Receive(ctx, transfer.ID, "WH-02", tt.items), tt.wantErr)
+				if tt.wantErr != nil {
+					return
+				}
+
+				trx, err := repo.FindByCode(ctx, "TRF-1")
+				expectErr(t, err, nil)
+				if trx.IDStatus != tt.wantStatus {
+					t.Fatalf("expected status %d, got %d", tt.wantStatus, trx.IDStatus)
+				}
+			})
+		}
+
+		stock, err := inventories.FindAllByVariant(ctx, "PRD-001", 2)
+		expectErr(t, err, nil)
+		if len(stock) != 2 || stock[1].CodeWarehouse != "WH-02" || stock[1].Quantity != 3 {
+			t.Fatalf("expected 3 received in WH-02, got %+v", stock)
+		}
+	})
+
+	t.Run("referenced variant cannot be deleted", func(t *testing.T) {
+		products := repository.NewProductRepository(db)
+		expectErr(t, products.Delete(ctx, 1), repository.ErrStillReferenced)
+	})
+}
@@ -0,0 +1,303 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/auth"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/response"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository/memory"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/service"
+)
+
+// atWarehouse mengembalikan context employee yang dibatasi ke satu warehouse
+func atWarehouse(code string) context.Context {
+	ctx := auth.WithClaims(context.Background(), &auth.Claims{EmployeeCode: "EMP-001", WarehouseCode: code})
+	return auth.WithScope(ctx, auth.WarehouseScope{WarehouseCode: code})
+}
+
+// newTransfer membuat transfer Pending 2 x variant 1 (PRD-001 size S) dari WH-01 ke WH-02
+func newTransfer(t *testing.T, transactions service.TransactionServices) string {
+	t.Helper()
+
+	trx, err := transactions.CreateTransfer(atWarehouse("WH-01"), &request.CreateTransferTransaction{
+		OriginWarehouseCode:      "WH-01",
+		DestinationWarehouseCode: "WH-02",
+		Items:                    []request.TransactionItem{{IDDetailProduct: 1, Quantity: 2}},
+	})
+	expectErr(t, err, nil)
+	return trx.CodeTransaksi
+}
+
+func TestChangeStatusCannotBypassTransferFlow(t *testing.T) {
+	newServices := func(store *memory.Store) service.TransactionServices {
+		return newTransactionServices(store, service.NewAuditServices(memory.NewAuditRepository(store)))
+	}
+
+	t.Run("in transit only through dispatch", func(t *testing.T) {
+		store := newMemoryStore(t)
+		transactions := newServices(store)
+		code := newTransfer(t, transactions)
+
+		// manager warehouse tujuan mencoba mengirim stok warehouse asal
+		_, err := transactions.ChangeStatus(atWarehouse("WH-02"), code, &request.ChangeTransactionStatus{IDStatus: models.StatusInTransit})
+		expectErr(t, err, service.ErrTransferSentByDispatch)
+
+		if got := stockOf(t, context.Background(), memory.NewInventoryRepository(store), "PRD-001", 1, "WH-01"); got != 10 {
+			t.Fatalf("expected origin stock to stay 10, got %d", got)
+		}
+	})
+
+	t.Run("completed only through receive", func(t *testing.T) {
+		transactions := newServices(newMemoryStore(t))
+		code := newTransfer(t, transactions)
+		_, err := transactions.DispatchTransfer(atWarehouse("WH-01"), code)
+		expectErr(t, err, nil)
+
+		_, err = transactions.ChangeStatus(atWarehouse("WH-02"), code, &request.ChangeTransactionStatus{IDStatus: models.StatusCompleted})
+		expectErr(t, err, service.ErrTransferCompletedByReceive)
+	})
+
+	t.Run("lost shipment can be failed", func(t *testing.T) {
+		store := newMemoryStore(t)
+		transactions := newServices(store)
+		code := newTransfer(t, transactions)
+		_, err := transactions.DispatchTransfer(atWarehouse("WH-01"), code)
+		expectErr(t, err, nil)
+
+		trx, err := transactions.ChangeStatus(atWarehouse("WH-01"), code, &request.ChangeTransactionStatus{IDStatus: models.StatusFailed})
+		expectErr(t, err, nil)
+		if trx.IDStatus != int(models.StatusFailed) {
+			t.Fatalf("expected Failed, got %s", trx.StatusName)
+		}
+
+		// barang yang hilang tidak kembali ke asal dan tidak sampai di tujuan
+		inventories := memory.NewInventoryRepository(store)
+		if origin, destination := stockOf(t, context.Background(), inventories, "PRD-001", 1, "WH-01"), stockOf(t, context.Background(), inventories, "PRD-001", 1, "WH-02"); origin != 8 || destination != 0 {
+			t.Fatalf("expected stock WH-01=8 WH-02=0, got %d and %d", origin, destination)
+		}
+	})
+}
+
+// newInbound membuat inbound Pending 4 x variant 1 (PRD-001 size S) ke WH-01
+func newInbound(t *testing.T, ctx context.Context, transactions service.TransactionServices) string {
+	t.Helper()
+
+	trx, err := transactions.CreateInbound(ctx, &request.CreateInboundTransaction{
+		OriginEntityName:         "PT Supplier",
+		DestinationWarehouseCode: "WH-01",
+		Items:                    []request.TransactionItem{{IDDetailProduct: 1, Quantity: 4}},
+	})
+	expectErr(t, err, nil)
+	return trx.CodeTransaksi
+}
+
+func TestTransactionStatusTransitions(t *testing.T) {
+	ctx := loggedIn(employeeClaims)
+	changeStatus := func(to uint) func(service.TransactionServices, string) error {
+		return func(transactions service.TransactionServices, code string) error {
+			_, err := transactions.ChangeStatus(ctx, code, &request.ChangeTransactionStatus{IDStatus: to})
+			return err
+		}
+	}
+	dispatch := func(transactions service.TransactionServices, code string) error {
+		_, err := transactions.DispatchTransfer(ctx, code)
+		return err
+	}
+	receiveAll := func(transactions service.TransactionServices, code string) error {
+		_, err := transactions.ReceiveTransfer(ctx, code, &request.ReceiveTransfer{Items: []request.TransactionItem{{IDDetailProduct: 1, Quantity: 2}}})
+		return err
+	}
+
+	tests := []struct {
+		name       string
+		transfer   bool
+		setup      []func(service.TransactionServices, string) error
+		move       func(service.TransactionServices, string) error
+		wantErr    error
+		wantStatus uint
+	}{
+		{name: "pending to completed", move: changeStatus(models.StatusCompleted), wantStatus: models.StatusCompleted},
+		{name: "pending to failed", move: changeStatus(models.StatusFailed), wantStatus: models.StatusFailed},
+		{name: "pending to pending", move: changeStatus(models.StatusPending), wantErr: service.ErrIllegalStatusTransition, wantStatus: models.StatusPending},
+		{name: "unknown status", move: changeStatus(99), wantErr: service.ErrUnknownStatus, wantStatus: models.StatusPending},
+		{
+			name: "completed is final", setup: []func(service.TransactionServices, string) error{changeStatus(models.StatusCompleted)},
+			move: changeStatus(models.StatusFailed), wantErr: service.ErrIllegalStatusTransition, wantStatus: models.StatusCompleted,
+		},
+		{
+			name: "failed is final", setup: []func(service.TransactionServices, string) error{changeStatus(models.StatusFailed)},
+			move: changeStatus(models.StatusCompleted), wantErr: service.ErrIllegalStatusTransition, wantStatus: models.StatusFailed,
+		},
+		{
+			name: "failed cannot reopen", setup: []func(service.TransactionServices, string) error{changeStatus(models.StatusFailed)},
+			move: changeStatus(models.StatusPending), wantErr: service.ErrIllegalStatusTransition, wantStatus: models.StatusFailed,
+		},
+		{name: "inbound cannot be dispatched", move: dispatch, wantErr: service.ErrNotTransfer, wantStatus: models.StatusPending},
+		{name: "transfer pending to in transit", transfer: true, move: dispatch, wantStatus: models.StatusInTransit},
+		{name: "transfer pending to failed", transfer: true, move: changeStatus(models.StatusFailed), wantStatus: models.StatusFailed},
+		{
+			name: "transfer dispatched twice", transfer: true, setup: []func(service.TransactionServices, string) error{dispatch},
+			move: dispatch, wantErr: service.ErrIllegalStatusTransition, wantStatus: models.StatusInTransit,
+		},
+		{
+			name: "transfer in transit back to pending", transfer: true, setup: []func(service.TransactionServices, string) error{dispatch},
+			move: changeStatus(models.StatusPending), wantErr: service.ErrIllegalStatusTransition, wantStatus: models.StatusInTransit,
+		},
+		{
+			name: "received transfer is final", transfer: true, setup: []func(service.TransactionServices, string) error{dispatch, receiveAll},
+			move: changeStatus(models.StatusFailed), wantErr: service.ErrIllegalStatusTransition, wantStatus: models.StatusCompleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemoryStore(t)
+			transactions := newTransactionServices(store, service.NewAuditServices(memory.NewAuditRepository(store)))
+
+			var code string
+			if tt.transfer {
+				code = newTransfer(t, transactions)
+			} else {
+				code = newInbound(t, ctx, transactions)
+			}
+			for _, setup := range tt.setup {
+				expectErr(t, setup(transactions, code), nil)
+			}
+
+			expectErr(t, tt.move(transactions, code), tt.wantErr)
+
+			trx, err := transactions.GetTransaction(ctx, code)
+			expectErr(t, err, nil)
+			if trx.IDStatus != int(tt.wantStatus) {
+				t.Fatalf("expected status %s, got %s", models.StatusNames[tt.wantStatus], trx.StatusName)
+			}
+		})
+	}
+}
+
+func TestScanItem(t *testing.T) {
+	ctx := loggedIn(employeeClaims)
+	scan := func(transactions service.TransactionServices, code, barcode string, quantity int) (*response.ScanProgressResponse, error) {
+		return transactions.ScanItem(ctx, code, &request.ScanItem{Barcode: barcode, Quantity: quantity})
+	}
+	// barcode variant 1 (PRD-001 size S) dari data test
+	const barcode = "4006381333931"
+
+	t.Run("scan until complete then over-scan", func(t *testing.T) {
+		store := newMemoryStore(t)
+		transactions := newTransactionServices(store, service.NewAuditServices(memory.NewAuditRepository(store)))
+		code := newInbound(t, ctx, transactions)
+
+		// quantity kosong dihitung 1
+		progress, err := scan(transactions, code, barcode, 0)
+		expectErr(t, err, nil)
+		if progress.TotalScanned != 1 || progress.TotalExpected != 4 || progress.Complete || progress.Scanned.IDDetailProduct != 1 {
+			t.Fatalf("unexpected progress %+v", progress)
+		}
+
+		// melebihi sisa baris ditolak tanpa mengubah progres
+		_, err = scan(transactions, code, barcode, 4)
+		expectErr(t, err, repository.ErrScanExceedsQuantity)
+
+		progress, err = scan(transactions, code, barcode, 3)
+		expectErr(t, err, nil)
+		if progress.TotalScanned != 4 || !progress.Complete {
+			t.Fatalf("expected complete progress, got %+v", progress)
+		}
+
+		_, err = scan(transactions, code, barcode, 1)
+		expectErr(t, err, repository.ErrScanExceedsQuantity)
+	})
+
+	t.Run("unknown barcode", func(t *testing.T) {
+		store := newMemoryStore(t)
+		transactions := newTransactionServices(store, service.NewAuditServices(memory.NewAuditRepository(store)))
+		code := newInbound(t, ctx, transactions)
+
+		_, err := scan(transactions, code, "5901234123457", 1)
+		expectErr(t, err, repository.ErrProductDetailNotFound)
+	})
+
+	t.Run("variant not in document", func(t *testing.T) {
+		store := newMemoryStore(t)
+		transactions := newTransactionServices(store, service.NewAuditServices(memory.NewAuditRepository(store)))
+		code := newInbound(t, ctx, transactions)
+
+		variant, err := memory.NewProductDetailRepository(store).FindById(context.Background(), 2)
+		expectErr(t, err, nil)
+		_, err = scan(transactions, code, variant.Barcode, 1)
+		expectErr(t, err, repository.ErrTransactionItemNotFound)
+	})
+
+	for _, final := range []uint{models.StatusCompleted, models.StatusFailed} {
+		t.Run(models.StatusNames[final]+" document", func(t *testing.T) {
+			store := newMemoryStore(t)
+			transactions := newTransactionServices(store, service.NewAuditServices(memory.NewAuditRepository(store)))
+			code := newInbound(t, ctx, transactions)
+			_, err := transactions.ChangeStatus(ctx, code, &request.ChangeTransactionStatus{IDStatus: final})
+			expectErr(t, err, nil)
+
+			_, err = scan(transactions, code, barcode, 1)
+			expectErr(t, err, service.ErrTransactionClosed)
+		})
+	}
+}
+
+func TestTransferDispatchAndPartialReceive(t *testing.T) {
+	store := newMemoryStore(t)
+	transactions := newTransactionServices(store, service.NewAuditServices(memory.NewAuditRepository(store)))
+	inventories := memory.NewInventoryRepository(store)
+	stock := func(warehouse string) int {
+		return stockOf(t, context.Background(), inventories, "PRD-001", 1, warehouse)
+	}
+	receive := func(ctx context.Context, code string, quantity int) (*response.TransactionResponse, error) {
+		return transactions.ReceiveTransfer(ctx, code, &request.ReceiveTransfer{Items: []request.TransactionItem{{IDDetailProduct: 1, Quantity: quantity}}})
+	}
+	code := newTransfer(t, transactions)
+
+	// belum dikirim: belum bisa diterima
+	_, err := receive(atWarehouse("WH-02"), code, 1)
+	expectErr(t, err, service.ErrTransactionNotInTransit)
+
+	// hanya warehouse asal yang boleh mengirim
+	_, err = transactions.DispatchTransfer(atWarehouse("WH-02"), code)
+	expectErr(t, err, service.ErrWarehouseForbidden)
+
+	trx, err := transactions.DispatchTransfer(atWarehouse("WH-01"), code)
+	expectErr(t, err, nil)
+	if trx.IDStatus != int(models.StatusInTransit) || stock("WH-01") != 8 || stock("WH-02") != 0 {
+		t.Fatalf("expected In Transit with WH-01=8 WH-02=0, got %s %d %d", trx.StatusName, stock("WH-01"), stock("WH-02"))
+	}
+
+	// hanya warehouse tujuan yang boleh menerima
+	_, err = receive(atWarehouse("WH-01"), code, 1)
+	expectErr(t, err, service.ErrWarehouseForbidden)
+
+	trx, err = receive(atWarehouse("WH-02"), code, 1)
+	expectErr(t, err, nil)
+	if trx.IDStatus != int(models.StatusInTransit) || trx.Items[0].ReceivedQuantity != 1 || stock("WH-02") != 1 {
+		t.Fatalf("expected partial receive to stay In Transit with WH-02=1, got %s received %d stock %d",
+			trx.StatusName, trx.Items[0].ReceivedQuantity, stock("WH-02"))
+	}
+
+	// menerima lebih dari sisa baris ditolak tanpa mengubah stok
+	_, err = receive(atWarehouse("WH-02"), code, 2)
+	expectErr(t, err, repository.ErrReceiveExceedsQuantity)
+	if stock("WH-02") != 1 {
+		t.Fatalf("expected WH-02 to stay 1, got %d", stock("WH-02"))
+	}
+
+	// sisa barang diterima: dokumen otomatis Completed
+	trx, err = receive(atWarehouse("WH-02"), code, 1)
+	expectErr(t, err, nil)
+	if trx.IDStatus != int(models.StatusCompleted) || stock("WH-01") != 8 || stock("WH-02") != 2 {
+		t.Fatalf("expected Completed with WH-01=8 WH-02=2, got %s %d %d", trx.StatusName, stock("WH-01"), stock("WH-02"))
+	}
+
+	_, err = receive(atWarehouse("WH-02"), code, 1)
+	expectErr(t, err, service.ErrTransactionNotInTransit)
+}
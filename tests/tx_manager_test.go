@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/models"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/repository/memory"
+)
+
+var errAbort = errors.New("abort transaction")
+
+// stockOf mengembalikan stok variant di warehouse, 0 jika belum ada baris inventory
+func stockOf(t *testing.T, ctx context.Context, repo repository.InventoryRepository, product string, size int, warehouse string) int {
+	t.Helper()
+
+	inventories, err := repo.FindAllByVariant(ctx, product, size)
+	expectErr(t, err, nil)
+	for _, inv := range inventories {
+		if inv.CodeWarehouse == warehouse {
+			return inv.Quantity
+		}
+	}
+	return 0
+}
+
+// testTxManager menjalankan skenario yang sama untuk TxManager PostgreSQL dan in-memory
+func testTxManager(t *testing.T, tx repository.TxManager, warehouses repository.WarehouseRepository, inventories repository.InventoryRepository) {
+	ctx := context.Background()
+
+	t.Run("commit spans repositories", func(t *testing.T) {
+		err := tx.WithinTx(ctx, func(ctx context.Context) error {
+			if err := warehouses.Save(ctx, &models.Warehouse{WarehouseName: "Commit", WarehouseCode: "WH-TX1"}); err != nil {
+				return err
+			}
+			_, err := inventories.Adjust(ctx, "PRD-001", 1, "WH-TX1", 3)
+			return err
+		})
+		expectErr(t, err, nil)
+
+		if got := stockOf(t, ctx, inventories, "PRD-001", 1, "WH-TX1"); got != 3 {
+			t.Fatalf("expected committed stock 3, got %d", got)
+		}
+	})
+
+	t.Run("error rolls back every repository", func(t *testing.T) {
+		err := tx.WithinTx(ctx, func(ctx context.Context) error {
+			if err := warehouses.Save(ctx, &models.Warehouse{WarehouseName: "Rollback", WarehouseCode: "WH-TX2"}); err != nil {
+				return err
+			}
+			if _, err := inventories.Adjust(ctx, "PRD-001", 1, "WH-01", -4); err != nil {
+				return err
+			}
+			return errAbort
+		})
+		expectErr(t, err, errAbort)
+
+		exists, err := warehouses.ExistsByCode(ctx, "WH-TX2")
+		expectErr(t, err, nil)
+		if exists {
+			t.Fatal("expected warehouse to be rolled back")
+		}
+		if got := stockOf(t, ctx, inventories, "PRD-001", 1, "WH-01"); got != 10 {
+			t.Fatalf("expected stock to be rolled back to 10, got %d", got)
+		}
+	})
+
+	t.Run("nested transaction rolls back to savepoint", func(t *testing.T) {
+		err := tx.WithinTx(ctx, func(ctx context.Context) error {
+			if _, err := inventories.Adjust(ctx, "PRD-001", 2, "WH-01", -1); err != nil {
+				return err
+			}
+
+			inner := tx.WithinTx(ctx, func(ctx context.Context) error {
+				if _, err := inventories.Adjust(ctx, "PRD-001", 2, "WH-01", -2); err != nil {
+					return err
+				}
+				return errAbort
+			})
+			expectErr(t, inner, errAbort)
+
+			// stok kurang ditolak repository tanpa membatalkan transaksi luar
+			_, err := inventories.Adjust(ctx, "PRD-001", 2, "WH-01", -100)
+			expectErr(t, err, repository.ErrInsufficientStock)
+			return nil
+		})
+		expectErr(t, err, nil)
+
+		if got := stockOf(t, ctx, inventories, "PRD-001", 2, "WH-01"); got != 4 {
+			t.Fatalf("expected only the outer adjustment to commit (stock 4), got %d", got)
+		}
+	})
+}
+
+func TestTxManager(t *testing.T) {
+	db := newTestDB(t)
+	warehouses := repository.NewWarehouseRepository(db)
+	inventories := repository.NewInventoryRepository(db)
+	ctx := context.Background()
+
+	testTxManager(t, repository.NewTxManager(db, repository.TxOptions{MaxRetries: repository.DefaultTxMaxRetries}), warehouses, inventories)
+
+	t.Run("read only rejects writes", func(t *testing.T) {
+		tx := repository.NewTxManager(db, repository.TxOptions{})
+		err := tx.WithinTxOptions(ctx, repository.TxOptions{ReadOnly: true}, func(ctx context.Context) error {
+			return warehouses.Save(ctx, &models.Warehouse{WarehouseName: "Read only", WarehouseCode: "WH-RO"})
+		})
+		if err == nil {
+			t.Fatal("expected write in read only transaction to fail")
+		}
+	})
+
+	t.Run("serialization failure is retried", func(t *testing.T) {
+		tx := repository.NewTxManager(db, repository.TxOptions{Isolation: sql.LevelSerializable, MaxRetries: 5})
+
+		// write skew: setiap transaksi membaca stok yang diubah transaksi lainnya,
+		// sehingga salah satunya harus gagal dengan 40001 lalu diulang
+		var attempts atomic.Int64
+		var read sync.WaitGroup
+		read.Add(2)
+
+		run := func(readSize, writeSize int) error {
+			first := true
+			return tx.WithinTx(ctx, func(ctx context.Context) error {
+				attempts.Add(1)
+				if _, err := inventories.FindAllByVariant(ctx, "PRD-001", readSize); err != nil {
+					return err
+				}
+				if first {
+					first = false
+					read.Done()
+					read.Wait()
+				}
+				_, err := inventories.Adjust(ctx, "PRD-001", writeSize, "WH-01", 1)
+				return err
+			})
+		}
+
+		errs := make(chan error, 2)
+		go func() { errs <- run(1, 2) }()
+		go func() { errs <- run(2, 1) }()
+		expectErr(t, <-errs, nil)
+		expectErr(t, <-errs, nil)
+
+		if attempts.Load() < 3 {
+			t.Fatalf("expected at least one retry, got %d attempts", attempts.Load())
+		}
+		if got := stockOf(t, ctx, inventories, "PRD-001", 1, "WH-01"); got != 11 {
+			t.Fatalf("expected both transactions to commit (stock 11), got %d", got)
+		}
+	})
+}
+
+func TestMemoryTxManager(t *testing.T) {
+	store := newMemoryStore(t)
+	testTxManager(t, memory.NewTxManager(store), memory.NewWarehouseRepository(store), memory.NewInventoryRepository(store))
+}
@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/dto/request"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/utils"
+	"github.com/AhmadKusumahDEV/Warehouse-Management-System/internal/validation"
+	"github.com/go-playground/validator/v10"
+)
+
+// newValidator membuat validator dengan tag binding dan aturan WMS seperti validator gin
+func newValidator(t *testing.T) *validator.Validate {
+	t.Helper()
+
+	v := validator.New()
+	v.SetTagName("binding")
+	if err := validation.Register(v); err != nil {
+		t.Fatalf("register validation: %v", err)
+	}
+	return v
+}
+
+func TestValidationTranslate(t *testing.T) {
+	v := newValidator(t)
+	// warehouse asal tidak valid, tujuan kosong dan quantity item bukan angka positif
+	transfer := request.CreateTransferTransaction{
+		OriginWarehouseCode: "gudang",
+		Items:               []request.TransactionItem{{IDDetailProduct: 1, Quantity: -1}},
+	}
+
+	tests := []struct {
+		lang string
+		want map[string]string
+	}{
+		{validation.LangID, map[string]string{
+			"origin_warehouse_code":      "origin_warehouse_code bukan kode warehouse yang valid",
+			"destination_warehouse_code": "destination_warehouse_code wajib diisi",
+			"items[0].quantity":          "quantity harus lebih besar dari 0",
+		}},
+		{validation.LangEN, map[string]string{
+			"origin_warehouse_code":      "origin_warehouse_code is not a valid warehouse code",
+			"destination_warehouse_code": "destination_warehouse_code is required",
+			"items[0].quantity":          "quantity must be greater than 0",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			err := validation.Translate(v.Struct(transfer), tt.lang)
+			expectErr(t, err, validation.ErrValidationFailed)
+
+			var verr *validation.Error
+			if !errors.As(err, &verr) {
+				t.Fatalf("expected *validation.Error, got %T", err)
+			}
+			got := map[string]string{}
+			for _, f := range verr.Fields {
+				got[f.Field] = f.Message
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected fields %v, got %v", tt.want, got)
+			}
+			for field, message := range tt.want {
+				if got[field] != message {
+					t.Fatalf("expected %s message %q, got %q", field, message, got[field])
+				}
+			}
+		})
+	}
+
+	t.Run("other errors are returned as is", func(t *testing.T) {
+		expectErr(t, validation.Translate(errAbort, validation.LangEN), errAbort)
+	})
+}
+
+func TestValidationLanguage(t *testing.T) {
+	tests := map[string]string{
+		"":                        validation.LangID,
+		"en-US,en;q=0.9":          validation.LangEN,
+		"fr-FR, en;q=0.8":         validation.LangEN,
+		"id-ID,id;q=0.9,en;q=0.8": validation.LangID,
+		"fr":                      validation.LangID,
+	}
+	for header, want := range tests {
+		if got := validation.Language(header); got != want {
+			t.Errorf("Language(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestEAN13CheckDigit(t *testing.T) {
+	check, err := utils.EAN13CheckDigit("400638133393")
+	expectErr(t, err, nil)
+	if check != 1 {
+		t.Fatalf("expected check digit 1, got %d", check)
+	}
+
+	_, err = utils.EAN13CheckDigit("40063813339")
+	if err == nil {
+		t.Fatal("expected an error for 11 digits")
+	}
+
+	if !utils.IsValidEAN13("4006381333931") || utils.IsValidEAN13("4006381333932") {
+		t.Fatal("expected only the correct check digit to be valid")
+	}
+
+	for range 20 {
+		code, err := utils.GenerateEAN13()
+		expectErr(t, err, nil)
+		if !strings.HasPrefix(code, "20") || !utils.IsValidEAN13(code) {
+			t.Fatalf("expected a valid internal EAN-13, got %q", code)
+		}
+	}
+
+	t.Run("barcode rule", func(t *testing.T) {
+		v := newValidator(t)
+		tests := []struct {
+			barcode string
+			valid   bool
+		}{
+			{"4006381333931", true},
+			// 13 digit dianggap EAN-13, jadi check digit yang salah ditolak
+			{"4006381333932", false},
+			{"WMS-ABC123", true},
+		}
+		for _, tt := range tests {
+			err := v.Struct(request.ScanItem{Barcode: tt.barcode})
+			if (err == nil) != tt.valid {
+				t.Errorf("barcode %q: expected valid=%v, got %v", tt.barcode, tt.valid, err)
+			}
+		}
+	})
+}